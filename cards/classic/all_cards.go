@@ -12,6 +12,34 @@ var BasicHeros = []interface{}{
 	&Guldan{},
 }
 
-var AllCards = append(BasicHeros, []interface{}{
+var BasicHeroPowers = []interface{}{
+	&Fireblast{},
+	&SteadyShot{},
+	&LesserHeal{},
+	&ArmorUp{},
+	&TotemicCall{},
+	&Reinforce{},
+	&DaggerMastery{},
+	&Shapeshift{},
+	&LifeTap{},
+
+	// Cards created by the basic hero powers
+	&HealingTotem{},
+	&SearingTotem{},
+	&StoneclawTotem{},
+	&WrathOfAirTotem{},
+	&SilverHandRecruit{},
+	&WickedKnife{},
+}
+
+var AllCards = append(append(BasicHeros, BasicHeroPowers...), []interface{}{
+	&TheCoin{},
 	&WaterElemental{},
+	&DireWolfAlpha{},
+	&SorcerersApprentice{},
+	&Counterspell{},
+	&MirrorEntity{},
+	&IceBarrier{},
+	&PowerOfTheWild{},
+	&Panther{}, // Summoned by Power of the Wild
 }...)
@@ -8,11 +8,12 @@ type Anduin struct{}
 
 func (a *Anduin) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Anduin Wrynn",
-		ZhName: "安度因·乌瑞恩",
-		ID:     "HERO_09",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Anduin Wrynn",
+		ZhName:    "安度因·乌瑞恩",
+		ID:        "HERO_09",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Lesser Heal",
 	}
 
 	cm.RegisterCard(card)
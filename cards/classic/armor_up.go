@@ -0,0 +1,30 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type ArmorUp struct{}
+
+func (a *ArmorUp) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Armor Up!",
+		ZhName:      "全副武装！",
+		ID:          "HERO_01bp",
+		Description: "英雄技能\n获得2点护甲值。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: a.Use,
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (a *ArmorUp) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	g.GainArmor(source.Owner.Hero, 2)
+}
@@ -0,0 +1,47 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type Counterspell struct{}
+
+func (c *Counterspell) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Counterspell",
+		ZhName:      "法术反制",
+		ID:          "EX1_287",
+		Description: "奥秘：当你的对手施放一个法术时，反制该法术。",
+		Cost:        3,
+		Type:        game.Spell,
+		Tags: []game.Tag{
+			game.NewTag(game.TAG_SECRET, true),
+		},
+		Load:   c.Load,
+		Unload: c.Unload,
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (c *Counterspell) Load(g *game.Game, self *game.Entity) {
+	g.TriggerManager.RegisterTrigger(game.TriggerCardPlayed, self, c.OnCardPlayed, false)
+}
+
+func (c *Counterspell) Unload(g *game.Game, self *game.Entity) {
+	g.TriggerManager.UnregisterAllForEntity(self)
+}
+
+func (c *Counterspell) OnCardPlayed(ctx *game.TriggerContext, self *game.Entity) {
+	if !ctx.Game.SecretActive(self) {
+		return
+	}
+
+	spell := ctx.SourceEntity
+	if spell == nil || spell.Card.Type != game.Spell || spell.Owner == self.Owner {
+		return
+	}
+
+	ctx.Game.RevealSecret(self)
+	ctx.Game.Counter(spell)
+}
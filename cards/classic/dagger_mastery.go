@@ -0,0 +1,34 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type DaggerMastery struct{}
+
+func (d *DaggerMastery) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Dagger Mastery",
+		ZhName:      "匕首精通",
+		ID:          "HERO_03bp",
+		Description: "英雄技能\n装备一把1/2的匕首。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: d.Use,
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (d *DaggerMastery) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	card, err := game.GetCardManager().CreateCardInstance("Wicked Knife")
+	if err != nil {
+		return
+	}
+	g.PlayWeapon(source.Owner, game.NewEntity(card, g, source.Owner), nil)
+}
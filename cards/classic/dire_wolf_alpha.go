@@ -0,0 +1,25 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type DireWolfAlpha struct{}
+
+func (d *DireWolfAlpha) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Dire Wolf Alpha",
+		ZhName:      "恐狼前锋",
+		ID:          "EX1_162",
+		Description: "相邻的随从获得+1攻击力。",
+		Cost:        2,
+		Attack:      2,
+		Health:      2,
+		Type:        game.Minion,
+		Auras: []game.Aura{
+			{Affects: game.AdjacentMinions, Buff: game.Buff{Attack: 1}},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
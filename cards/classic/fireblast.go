@@ -0,0 +1,33 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type Fireblast struct{}
+
+func (f *Fireblast) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Fireblast",
+		ZhName:      "火焰冲击",
+		ID:          "HERO_08bp",
+		Description: "英雄技能\n造成1点伤害。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: f.Use,
+			},
+		},
+		Requirements: map[game.PlayRequirement]int{
+			game.REQ_TARGET_TO_PLAY: 0,
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (f *Fireblast) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	g.DealHeroPowerDamage(source, target, 1)
+}
@@ -8,11 +8,12 @@ type Garrosh struct{}
 
 func (g *Garrosh) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Garrosh Hellscream",
-		ZhName: "加尔鲁什·地狱咆哮",
-		ID:     "HERO_01",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Garrosh Hellscream",
+		ZhName:    "加尔鲁什·地狱咆哮",
+		ID:        "HERO_01",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Armor Up!",
 	}
 
 	cm.RegisterCard(card)
@@ -8,11 +8,12 @@ type Guldan struct{}
 
 func (g *Guldan) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Gul'dan",
-		ZhName: "古尔丹",
-		ID:     "HERO_07",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Gul'dan",
+		ZhName:    "古尔丹",
+		ID:        "HERO_07",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Life Tap",
 	}
 
 	cm.RegisterCard(card)
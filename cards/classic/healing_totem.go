@@ -0,0 +1,45 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type HealingTotem struct{}
+
+func (h *HealingTotem) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Healing Totem",
+		ZhName:      "治疗图腾",
+		ID:          "NEW1_009",
+		Description: "在你的回合结束时，为所有友方随从恢复1点生命值。",
+		Cost:        1,
+		Attack:      0,
+		Health:      2,
+		Type:        game.Minion,
+		Load:        h.Load,
+		Unload:      h.Unload,
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (h *HealingTotem) Load(g *game.Game, self *game.Entity) {
+	g.TriggerManager.RegisterTrigger(game.TriggerTurnEnd, self, h.OnTurnEnd, false)
+}
+
+func (h *HealingTotem) Unload(g *game.Game, self *game.Entity) {
+	g.TriggerManager.UnregisterAllForEntity(self)
+}
+
+func (h *HealingTotem) OnTurnEnd(ctx *game.TriggerContext, self *game.Entity) {
+	if self.CurrentZone != game.ZONE_PLAY { // only work when self is in play
+		return
+	}
+	if ctx.Game.CurrentPlayer != self.Owner { // only at the end of your turn
+		return
+	}
+
+	for _, minion := range self.Owner.Field {
+		ctx.Game.Heal(self, minion, 1)
+	}
+}
@@ -0,0 +1,47 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type IceBarrier struct{}
+
+func (i *IceBarrier) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Ice Barrier",
+		ZhName:      "寒冰护体",
+		ID:          "EX1_289",
+		Description: "奥秘：当你的英雄受到攻击时，获得8点护甲值。",
+		Cost:        3,
+		Type:        game.Spell,
+		Tags: []game.Tag{
+			game.NewTag(game.TAG_SECRET, true),
+		},
+		Load:   i.Load,
+		Unload: i.Unload,
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (i *IceBarrier) Load(g *game.Game, self *game.Entity) {
+	g.TriggerManager.RegisterTrigger(game.TriggerBeforeAttack, self, i.OnAttack, false)
+}
+
+func (i *IceBarrier) Unload(g *game.Game, self *game.Entity) {
+	g.TriggerManager.UnregisterAllForEntity(self)
+}
+
+func (i *IceBarrier) OnAttack(ctx *game.TriggerContext, self *game.Entity) {
+	if !ctx.Game.SecretActive(self) {
+		return
+	}
+
+	hero := self.Owner.Hero
+	if ctx.TargetEntity == nil || ctx.TargetEntity != hero {
+		return
+	}
+
+	ctx.Game.RevealSecret(self)
+	ctx.Game.GainArmor(hero, 8)
+}
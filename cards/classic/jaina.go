@@ -8,11 +8,12 @@ type Jaina struct{}
 
 func (j *Jaina) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Jaina Proudmoore",
-		ZhName: "吉安娜·普罗德摩尔",
-		ID:     "HERO_08",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Jaina Proudmoore",
+		ZhName:    "吉安娜·普罗德摩尔",
+		ID:        "HERO_08",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Fireblast",
 	}
 
 	cm.RegisterCard(card)
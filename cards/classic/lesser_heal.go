@@ -0,0 +1,33 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type LesserHeal struct{}
+
+func (l *LesserHeal) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Lesser Heal",
+		ZhName:      "次级治疗术",
+		ID:          "HERO_09bp",
+		Description: "英雄技能\n恢复2点生命值。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: l.Use,
+			},
+		},
+		Requirements: map[game.PlayRequirement]int{
+			game.REQ_TARGET_TO_PLAY: 0,
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (l *LesserHeal) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	g.Heal(source, target, 2)
+}
@@ -0,0 +1,31 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type LifeTap struct{}
+
+func (l *LifeTap) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Life Tap",
+		ZhName:      "生命分流",
+		ID:          "HERO_07bp",
+		Description: "英雄技能\n抽一张牌并受到2点伤害。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: l.Use,
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (l *LifeTap) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	g.DrawCard(source.Owner)
+	g.DealHeroPowerDamage(source, source.Owner.Hero, 2)
+}
@@ -8,11 +8,12 @@ type Malfurion struct{}
 
 func (m *Malfurion) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Malfurion Stormrage",
-		ZhName: "玛法里奥·怒风",
-		ID:     "HERO_06",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Malfurion Stormrage",
+		ZhName:    "玛法里奥·怒风",
+		ID:        "HERO_06",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Shapeshift",
 	}
 
 	cm.RegisterCard(card)
@@ -0,0 +1,53 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type MirrorEntity struct{}
+
+func (m *MirrorEntity) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Mirror Entity",
+		ZhName:      "镜像实体",
+		ID:          "EX1_294",
+		Description: "奥秘：在你的对手使用一张随从牌后，召唤一个该随从的复制。",
+		Cost:        3,
+		Type:        game.Spell,
+		Tags: []game.Tag{
+			game.NewTag(game.TAG_SECRET, true),
+		},
+		Load:   m.Load,
+		Unload: m.Unload,
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (m *MirrorEntity) Load(g *game.Game, self *game.Entity) {
+	g.TriggerManager.RegisterTrigger(game.TriggerMinionPlayed, self, m.OnMinionPlayed, false)
+}
+
+func (m *MirrorEntity) Unload(g *game.Game, self *game.Entity) {
+	g.TriggerManager.UnregisterAllForEntity(self)
+}
+
+func (m *MirrorEntity) OnMinionPlayed(ctx *game.TriggerContext, self *game.Entity) {
+	if !ctx.Game.SecretActive(self) {
+		return
+	}
+
+	minion := ctx.SourceEntity
+	if minion == nil || minion.Owner == self.Owner {
+		return
+	}
+
+	// The secret stays hidden if there is no room for the copy
+	player := self.Owner
+	if len(player.Field) >= player.FieldSize {
+		return
+	}
+
+	ctx.Game.RevealSecret(self)
+	ctx.Game.AddEntityToField(player, ctx.Game.CopyEntity(minion, player), -1)
+}
@@ -0,0 +1,21 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type Panther struct{}
+
+func (p *Panther) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:   "Panther",
+		ZhName: "猎豹",
+		ID:     "EX1_160t",
+		Cost:   2,
+		Attack: 3,
+		Health: 2,
+		Type:   game.Minion,
+	}
+
+	cm.RegisterCard(card)
+}
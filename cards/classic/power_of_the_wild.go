@@ -0,0 +1,42 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type PowerOfTheWild struct{}
+
+func (p *PowerOfTheWild) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Power of the Wild",
+		ZhName:      "野性之力",
+		ID:          "EX1_160",
+		Description: "抉择：使你的所有随从获得+1/+1；或者召唤一只3/2的猎豹。",
+		Cost:        2,
+		Type:        game.Spell,
+		ChooseOne: []game.ChooseOneOption{
+			{
+				Name:   "Leader of the Pack",
+				ZhName: "兽群领袖",
+				Powers: []game.Power{{Type: game.PowerTypeSpell, Action: p.BuffMinions}},
+			},
+			{
+				Name:   "Summon a Panther",
+				ZhName: "召唤猎豹",
+				Powers: []game.Power{{Type: game.PowerTypeSpell, Action: p.SummonPanther}},
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (p *PowerOfTheWild) BuffMinions(g *game.Game, source *game.Entity, target *game.Entity) {
+	for _, minion := range source.Owner.Field {
+		g.AddBuff(minion, game.Buff{Source: source, Attack: 1, Health: 1})
+	}
+}
+
+func (p *PowerOfTheWild) SummonPanther(g *game.Game, source *game.Entity, target *game.Entity) {
+	g.Summon(source.Owner, "Panther", -1)
+}
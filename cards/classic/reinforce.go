@@ -0,0 +1,33 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type Reinforce struct{}
+
+func (r *Reinforce) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Reinforce",
+		ZhName:      "援军",
+		ID:          "HERO_04bp",
+		Description: "英雄技能\n召唤一个1/1的白银之手新兵。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: r.Use,
+			},
+		},
+		Requirements: map[game.PlayRequirement]int{
+			game.REQ_NUM_MINION_SLOTS: 1,
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (r *Reinforce) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	g.Summon(source.Owner, "Silver Hand Recruit", -1)
+}
@@ -8,11 +8,12 @@ type Rexxar struct{}
 
 func (r *Rexxar) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Rexxar",
-		ZhName: "雷克萨",
-		ID:     "HERO_05",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Rexxar",
+		ZhName:    "雷克萨",
+		ID:        "HERO_05",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Steady Shot",
 	}
 
 	cm.RegisterCard(card)
@@ -0,0 +1,21 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type SearingTotem struct{}
+
+func (s *SearingTotem) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:   "Searing Totem",
+		ZhName: "灼热图腾",
+		ID:     "CS2_050",
+		Cost:   1,
+		Attack: 1,
+		Health: 1,
+		Type:   game.Minion,
+	}
+
+	cm.RegisterCard(card)
+}
@@ -0,0 +1,36 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type Shapeshift struct{}
+
+func (s *Shapeshift) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Shapeshift",
+		ZhName:      "变形",
+		ID:          "HERO_06bp",
+		Description: "英雄技能\n在本回合中，获得+1攻击力。获得1点护甲值。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: s.Use,
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (s *Shapeshift) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	hero := source.Owner.Hero
+	if hero == nil {
+		return
+	}
+
+	g.AddBuff(hero, game.Buff{Source: source, Attack: 1, Duration: game.BuffThisTurn})
+	g.GainArmor(hero, 1)
+}
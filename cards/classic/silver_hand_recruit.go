@@ -0,0 +1,21 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type SilverHandRecruit struct{}
+
+func (s *SilverHandRecruit) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:   "Silver Hand Recruit",
+		ZhName: "白银之手新兵",
+		ID:     "CS2_101t",
+		Cost:   1,
+		Attack: 1,
+		Health: 1,
+		Type:   game.Minion,
+	}
+
+	cm.RegisterCard(card)
+}
@@ -0,0 +1,25 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type SorcerersApprentice struct{}
+
+func (s *SorcerersApprentice) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Sorcerer's Apprentice",
+		ZhName:      "巫师学徒",
+		ID:          "EX1_608",
+		Description: "你的法术的法力值消耗减少（1）点。",
+		Cost:        2,
+		Attack:      3,
+		Health:      2,
+		Type:        game.Minion,
+		Auras: []game.Aura{
+			{Affects: game.FriendlySpellsInHand, Buff: game.Buff{Cost: -1}},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
@@ -0,0 +1,34 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type SteadyShot struct{}
+
+func (s *SteadyShot) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Steady Shot",
+		ZhName:      "稳固射击",
+		ID:          "HERO_05bp",
+		Description: "英雄技能\n对敌方英雄造成2点伤害。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: s.Use,
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (s *SteadyShot) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	opponent := g.Opponent(source.Owner)
+	if opponent == nil {
+		return
+	}
+	g.DealHeroPowerDamage(source, opponent.Hero, 2)
+}
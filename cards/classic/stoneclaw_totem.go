@@ -0,0 +1,25 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type StoneclawTotem struct{}
+
+func (s *StoneclawTotem) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Stoneclaw Totem",
+		ZhName:      "石爪图腾",
+		ID:          "CS2_051",
+		Description: "嘲讽",
+		Cost:        1,
+		Attack:      0,
+		Health:      2,
+		Type:        game.Minion,
+		Tags: []game.Tag{
+			game.NewTag(game.TAG_TAUNT, true),
+		},
+	}
+
+	cm.RegisterCard(card)
+}
@@ -0,0 +1,32 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type TheCoin struct{}
+
+func (c *TheCoin) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "The Coin",
+		ZhName:      "幸运币",
+		ID:          "GAME_005",
+		Description: "在本回合中，获得一个法力水晶。",
+		Cost:        0,
+		Type:        game.Spell,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeSpell,
+				Action: c.Cast,
+			},
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (c *TheCoin) Cast(g *game.Game, source *game.Entity, target *game.Entity) {
+	if source.Owner != nil {
+		g.GainTemporaryMana(source.Owner, 1)
+	}
+}
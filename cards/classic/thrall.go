@@ -8,11 +8,12 @@ type Thrall struct{}
 
 func (t *Thrall) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Thrall",
-		ZhName: "萨尔",
-		ID:     "HERO_02",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Thrall",
+		ZhName:    "萨尔",
+		ID:        "HERO_02",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Totemic Call",
 	}
 
 	cm.RegisterCard(card)
@@ -0,0 +1,62 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+// BasicTotems are the totems Totemic Call can summon
+var BasicTotems = []string{
+	"Healing Totem",
+	"Searing Totem",
+	"Stoneclaw Totem",
+	"Wrath of Air Totem",
+}
+
+type TotemicCall struct{}
+
+func (t *TotemicCall) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Totemic Call",
+		ZhName:      "图腾召唤",
+		ID:          "HERO_02bp",
+		Description: "英雄技能\n随机召唤一个图腾。",
+		Cost:        2,
+		Type:        game.HeroPower,
+		Powers: []game.Power{
+			{
+				Type:   game.PowerTypeHeroPower,
+				Action: t.Use,
+			},
+		},
+		Requirements: map[game.PlayRequirement]int{
+			game.REQ_NUM_MINION_SLOTS: 1,
+		},
+	}
+
+	cm.RegisterCard(card)
+}
+
+func (t *TotemicCall) Use(g *game.Game, source *game.Entity, target *game.Entity) {
+	player := source.Owner
+
+	// Only summon totems that are not already on the field
+	candidates := make([]string, 0, len(BasicTotems))
+	for _, totem := range BasicTotems {
+		onField := false
+		for _, minion := range player.Field {
+			if minion.Card.Name == totem {
+				onField = true
+				break
+			}
+		}
+		if !onField {
+			candidates = append(candidates, totem)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return
+	}
+
+	g.Summon(player, candidates[g.RandomInt(len(candidates))], -1)
+}
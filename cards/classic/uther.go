@@ -8,11 +8,12 @@ type Uther struct{}
 
 func (u *Uther) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Uther Lightbringer",
-		ZhName: "乌瑟尔·光明使者",
-		ID:     "HERO_04",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Uther Lightbringer",
+		ZhName:    "乌瑟尔·光明使者",
+		ID:        "HERO_04",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Reinforce",
 	}
 
 	cm.RegisterCard(card)
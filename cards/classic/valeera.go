@@ -8,11 +8,12 @@ type Valeera struct{}
 
 func (v *Valeera) Register(cm *game.CardManager) {
 	card := game.Card{
-		Name:   "Valeera Sanguinar",
-		ZhName: "瓦莉拉·萨古纳尔",
-		ID:     "HERO_03",
-		Health: 30,
-		Type:   game.Hero,
+		Name:      "Valeera Sanguinar",
+		ZhName:    "瓦莉拉·萨古纳尔",
+		ID:        "HERO_03",
+		Health:    30,
+		Type:      game.Hero,
+		HeroPower: "Dagger Mastery",
 	}
 
 	cm.RegisterCard(card)
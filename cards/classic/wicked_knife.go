@@ -0,0 +1,21 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type WickedKnife struct{}
+
+func (w *WickedKnife) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:   "Wicked Knife",
+		ZhName: "邪恶短刀",
+		ID:     "CS2_082",
+		Cost:   1,
+		Attack: 1,
+		Health: 2,
+		Type:   game.Weapon,
+	}
+
+	cm.RegisterCard(card)
+}
@@ -0,0 +1,25 @@
+package cards
+
+import (
+	"github.com/openhs/internal/game"
+)
+
+type WrathOfAirTotem struct{}
+
+func (w *WrathOfAirTotem) Register(cm *game.CardManager) {
+	card := game.Card{
+		Name:        "Wrath of Air Totem",
+		ZhName:      "空气之怒图腾",
+		ID:          "CS2_052",
+		Description: "法术伤害+1",
+		Cost:        1,
+		Attack:      0,
+		Health:      2,
+		Type:        game.Minion,
+		Tags: []game.Tag{
+			game.NewTag(game.TAG_SPELLPOWER, 1),
+		},
+	}
+
+	cm.RegisterCard(card)
+}
@@ -20,11 +20,14 @@ func (f *Fireball) Register(cm *game.CardManager) {
 				Action: f.Cast,
 			},
 		},
+		Requirements: map[game.PlayRequirement]int{
+			game.REQ_TARGET_TO_PLAY: 0,
+		},
 	}
 
 	cm.RegisterCard(card)
 }
 
 func (f *Fireball) Cast(g *game.Game, source *game.Entity, target *game.Entity) {
-	g.DealDamage(source, target, 6)
+	g.DealSpellDamage(source, target, 6)
 }
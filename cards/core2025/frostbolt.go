@@ -20,6 +20,9 @@ func (f *Frostbolt) Register(cm *game.CardManager) {
 				Action: f.Cast,
 			},
 		},
+		Requirements: map[game.PlayRequirement]int{
+			game.REQ_TARGET_TO_PLAY: 0,
+		},
 	}
 
 	cm.RegisterCard(card)
@@ -27,7 +30,7 @@ func (f *Frostbolt) Register(cm *game.CardManager) {
 
 func (f *Frostbolt) Cast(g *game.Game, source *game.Entity, target *game.Entity) {
 	// Deal 3 damage to the target
-	g.DealDamage(source, target, 3)
+	g.DealSpellDamage(source, target, 3)
 
 	// Freeze the target
 	g.Freeze(target)
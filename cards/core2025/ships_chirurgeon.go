@@ -48,6 +48,5 @@ func (s *ShipsChirurgeon) OnSummon(ctx *game.TriggerContext, self *game.Entity)
 		return
 	}
 
-	summonedMinion.Health++
-	summonedMinion.MaxHealth++
+	ctx.Game.AddBuff(summonedMinion, game.Buff{Source: self, Health: 1})
 }
@@ -35,6 +35,13 @@ func main() {
 		return
 	}
 
+	// Print the seed so the game can be reproduced
+	if displayLang == "zh" {
+		fmt.Printf("随机种子: %d\n", g.Seed)
+	} else {
+		fmt.Printf("Random seed: %d\n", g.Seed)
+	}
+
 	// Create a new engine
 	e := engine.NewEngine(g)
 
@@ -50,7 +57,15 @@ func main() {
 	running := true
 
 	for running {
+		if g.Phase == game.BeginMulligan {
+			if !handleMulligan(e, g, scanner) {
+				break
+			}
+			continue
+		}
+
 		displayGameState(g)
+		displayPendingChoice(g)
 
 		displayCommands()
 
@@ -68,14 +83,52 @@ func main() {
 			handlePlayCard(e, g, parts)
 		case "a":
 			handleAttack(e, g, parts)
+		case "h":
+			handleHeroPower(e, g, parts)
+		case "d":
+			handleChoose(e, g, parts)
 		case "e":
-			e.EndPlayerTurn()
+			e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: g.CurrentPlayer})
+		case "c":
+			e.PerformPlayerAction(game.Action{Type: game.ActionConcede, Player: g.CurrentPlayer})
 		case "q":
 			running = false
 			fmt.Println("Thanks for playing!")
 		default:
 			fmt.Println("Unknown command")
 		}
+
+		if g.Phase == game.FinalGameover {
+			displayGameResult(g)
+			running = false
+		}
+	}
+}
+
+func displayGameResult(g *game.Game) {
+	winner := g.Winner()
+	if displayLang == "zh" {
+		fmt.Printf("\n=== 游戏结束 ===\n")
+		if winner == nil {
+			fmt.Println("平局!")
+		} else {
+			for i, player := range g.Players {
+				if player == winner {
+					fmt.Printf("%s (%s) 获胜!\n", player.Hero.Card.ZhName, []string{"先手", "后手"}[i])
+				}
+			}
+		}
+	} else {
+		fmt.Printf("\n=== Game Over ===\n")
+		if winner == nil {
+			fmt.Println("It's a draw!")
+		} else {
+			for i, player := range g.Players {
+				if player == winner {
+					fmt.Printf("%s (%s) wins!\n", player.Hero.Card.Name, []string{"First", "Second"}[i])
+				}
+			}
+		}
 	}
 }
 
@@ -92,16 +145,22 @@ func displayHello() {
 func displayCommands() {
 	if displayLang == "zh" {
 		fmt.Println("\n可用指令:")
-		fmt.Println("  p <card_number> [<position>] - 从手牌中打出一张牌")
-		fmt.Println("  a <attacker_number> <defender_number> - 用你的随从攻击")
+		fmt.Println("  p <card_number> [<position>] [<target>] [c<choice>] - 从手牌中打出一张牌, 抉择牌用 c1/c2 选择")
+		fmt.Println("  a <attacker_number> <defender_number> - 用你的随从攻击 (0 代表英雄)")
+		fmt.Println("  h [<target>] - 使用英雄技能 (目标: e0 敌方英雄, e1.. 敌方随从, f0 我方英雄, f1.. 我方随从)")
+		fmt.Println("  d <option_number> - 选择一张发现的牌")
 		fmt.Println("  e - 结束你的回合")
+		fmt.Println("  c - 投降")
 		fmt.Println("  q - 退出游戏")
 		fmt.Print("\n输入指令: ")
 	} else {
 		fmt.Println("\nCommands:")
-		fmt.Println("  p <card_number> [<position>] - Play a card from your hand")
-		fmt.Println("  a <attacker_number> <defender_number> - Attack with your minion")
+		fmt.Println("  p <card_number> [<position>] [<target>] [c<choice>] - Play a card from your hand, pick a Choose One option with c1/c2")
+		fmt.Println("  a <attacker_number> <defender_number> - Attack with your minion (0 for the hero)")
+		fmt.Println("  h [<target>] - Use your hero power (target: e0 enemy hero, e1.. enemy minions, f0 your hero, f1.. your minions)")
+		fmt.Println("  d <option_number> - Pick a discovered card")
 		fmt.Println("  e - End your turn")
+		fmt.Println("  c - Concede the game")
 		fmt.Println("  q - Quit the game")
 		fmt.Print("\nEnter command: ")
 	}
@@ -115,6 +174,12 @@ func displayGameState(g *game.Game) {
 		fmt.Printf("当前玩家: %s (%s)\n", g.CurrentPlayer.Hero.Card.ZhName, []string{"先手", "后手"}[g.CurrentPlayerIndex])
 		fmt.Printf("法力水晶: %d/%d\n", g.CurrentPlayer.Mana, g.CurrentPlayer.TotalMana)
 		fmt.Printf("生命值: %d\n", g.CurrentPlayer.Hero.Health)
+		if g.CurrentPlayer.Hero.Armor > 0 {
+			fmt.Printf("护甲值: %d\n", g.CurrentPlayer.Hero.Armor)
+		}
+		if attack := g.EffectiveAttack(g.CurrentPlayer.Hero); attack > 0 {
+			fmt.Printf("英雄攻击力: %d\n", attack)
+		}
 		if g.CurrentPlayer.FatigueDamage > 0 {
 			fmt.Printf("下一次疲劳伤害: %d\n", g.CurrentPlayer.FatigueDamage)
 		}
@@ -124,6 +189,12 @@ func displayGameState(g *game.Game) {
 		fmt.Printf("Current Player: %s (%s)\n", g.CurrentPlayer.Hero.Card.Name, []string{"First", "Second"}[g.CurrentPlayerIndex])
 		fmt.Printf("Player Mana: %d/%d\n", g.CurrentPlayer.Mana, g.CurrentPlayer.TotalMana)
 		fmt.Printf("Player Health: %d\n", g.CurrentPlayer.Hero.Health)
+		if g.CurrentPlayer.Hero.Armor > 0 {
+			fmt.Printf("Player Armor: %d\n", g.CurrentPlayer.Hero.Armor)
+		}
+		if attack := g.EffectiveAttack(g.CurrentPlayer.Hero); attack > 0 {
+			fmt.Printf("Hero Attack: %d\n", attack)
+		}
 		if g.CurrentPlayer.FatigueDamage > 0 {
 			fmt.Printf("Next Fatigue Damage: %d\n", g.CurrentPlayer.FatigueDamage)
 		}
@@ -137,7 +208,7 @@ func displayGameState(g *game.Game) {
 			fmt.Println("(无随从)")
 		}
 		for i, card := range opponent.Field {
-			fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.ZhName, card.Attack, card.Health)
+			fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.ZhName, g.EffectiveAttack(card), card.Health)
 		}
 	} else {
 		fmt.Printf("\nOpponent Player's Field:\n")
@@ -145,7 +216,7 @@ func displayGameState(g *game.Game) {
 			fmt.Println("(Empty field)")
 		}
 		for i, card := range opponent.Field {
-			fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.Name, card.Attack, card.Health)
+			fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.Name, g.EffectiveAttack(card), card.Health)
 		}
 	}
 
@@ -156,7 +227,7 @@ func displayGameState(g *game.Game) {
 			fmt.Println("(无随从)")
 		} else {
 			for i, card := range g.CurrentPlayer.Field {
-				fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.ZhName, card.Attack, card.Health)
+				fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.ZhName, g.EffectiveAttack(card), card.Health)
 			}
 		}
 	} else {
@@ -165,7 +236,7 @@ func displayGameState(g *game.Game) {
 			fmt.Println("(Empty field)")
 		} else {
 			for i, card := range g.CurrentPlayer.Field {
-				fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.Name, card.Attack, card.Health)
+				fmt.Printf("  %d. %s (%d/%d)\n", i+1, card.Card.Name, g.EffectiveAttack(card), card.Health)
 			}
 		}
 	}
@@ -185,6 +256,40 @@ func displayGameState(g *game.Game) {
 		}
 	}
 
+	// Print hero power
+	if heroPower := g.CurrentPlayer.HeroPower; heroPower != nil {
+		if displayLang == "zh" {
+			status := "可用"
+			if heroPower.Exhausted {
+				status = "已使用"
+			}
+			fmt.Printf("\n英雄技能: %s (%d费, %s)\n", heroPower.Card.ZhName, g.EffectiveCost(heroPower), status)
+		} else {
+			status := "ready"
+			if heroPower.Exhausted {
+				status = "used"
+			}
+			fmt.Printf("\nHero Power: %s (Cost: %d, %s)\n", heroPower.Card.Name, g.EffectiveCost(heroPower), status)
+		}
+	}
+
+	// Print secrets, the opponent's secrets stay hidden
+	if len(g.CurrentPlayer.Secrets) > 0 || len(opponent.Secrets) > 0 {
+		names := make([]string, 0, len(g.CurrentPlayer.Secrets))
+		for _, secret := range g.CurrentPlayer.Secrets {
+			if displayLang == "zh" {
+				names = append(names, secret.Card.ZhName)
+			} else {
+				names = append(names, secret.Card.Name)
+			}
+		}
+		if displayLang == "zh" {
+			fmt.Printf("\n奥秘: %s (对手奥秘: %d)\n", strings.Join(names, ", "), len(opponent.Secrets))
+		} else {
+			fmt.Printf("\nSecrets: %s (Opponent Secrets: %d)\n", strings.Join(names, ", "), len(opponent.Secrets))
+		}
+	}
+
 	// Print the current player's hand
 	if displayLang == "zh" {
 		fmt.Printf("\n当前手牌:\n")
@@ -197,9 +302,11 @@ func displayGameState(g *game.Game) {
 					card.Card.ZhName,
 				}
 
-				// Add cost
-				if card.Card.Cost > 0 {
-					cardInfo = append(cardInfo, fmt.Sprintf("费用: %d", card.Card.Cost))
+				// Add the effective cost
+				if cost := g.EffectiveCost(card); game.CostsHealth(card) {
+					cardInfo = append(cardInfo, fmt.Sprintf("费用: %d生命值", cost))
+				} else if cost > 0 {
+					cardInfo = append(cardInfo, fmt.Sprintf("费用: %d", cost))
 				}
 
 				// Add attack and health for minions and weapons
@@ -209,6 +316,11 @@ func displayGameState(g *game.Game) {
 					cardInfo = append(cardInfo, fmt.Sprintf("攻击: %d, 耐久: %d", card.Attack, card.Health))
 				}
 
+				// Add choose one options
+				for j, option := range card.Card.ChooseOne {
+					cardInfo = append(cardInfo, fmt.Sprintf("c%d: %s", j+1, option.ZhName))
+				}
+
 				fmt.Printf("  %d. %s\n", i+1, strings.Join(cardInfo, ", "))
 			}
 		}
@@ -223,9 +335,11 @@ func displayGameState(g *game.Game) {
 					card.Card.Name,
 				}
 
-				// Add cost
-				if card.Card.Cost > 0 {
-					cardInfo = append(cardInfo, fmt.Sprintf("Cost: %d", card.Card.Cost))
+				// Add the effective cost
+				if cost := g.EffectiveCost(card); game.CostsHealth(card) {
+					cardInfo = append(cardInfo, fmt.Sprintf("Cost: %d Health", cost))
+				} else if cost > 0 {
+					cardInfo = append(cardInfo, fmt.Sprintf("Cost: %d", cost))
 				}
 
 				// Add attack and health for minions and weapons
@@ -235,12 +349,86 @@ func displayGameState(g *game.Game) {
 					cardInfo = append(cardInfo, fmt.Sprintf("Attack: %d, Durability: %d", card.Attack, card.Health))
 				}
 
+				// Add choose one options
+				for j, option := range card.Card.ChooseOne {
+					cardInfo = append(cardInfo, fmt.Sprintf("c%d: %s", j+1, option.Name))
+				}
+
 				fmt.Printf("  %d. %s\n", i+1, strings.Join(cardInfo, ", "))
 			}
 		}
 	}
 }
 
+// displayPendingChoice prints the cards a player can discover
+func displayPendingChoice(g *game.Game) {
+	for _, player := range g.Players {
+		choice := player.PendingChoice
+		if choice == nil || choice.Type != game.ChoiceDiscover {
+			continue
+		}
+
+		if displayLang == "zh" {
+			fmt.Printf("\n发现 (输入 d <编号> 选择):\n")
+		} else {
+			fmt.Printf("\nDiscover (enter d <number> to pick):\n")
+		}
+		for i, option := range choice.Options {
+			if displayLang == "zh" {
+				fmt.Printf("  %d. %s %s (%d费)\n", i+1, option.Card.Type.ZhString(), option.Card.ZhName, option.Card.Cost)
+			} else {
+				fmt.Printf("  %d. %s %s (Cost: %d)\n", i+1, option.Card.Type.String(), option.Card.Name, option.Card.Cost)
+			}
+		}
+	}
+}
+
+// handleMulligan asks the next player with a pending mulligan which cards to replace
+// Returns false if input has ended
+func handleMulligan(e *engine.Engine, g *game.Game, scanner *bufio.Scanner) bool {
+	for i, player := range g.Players {
+		if player.PendingChoice == nil || player.PendingChoice.Type != game.ChoiceMulligan {
+			continue
+		}
+
+		if displayLang == "zh" {
+			fmt.Printf("\n=== 调度阶段: %s ===\n", []string{"先手", "后手"}[i])
+			for j, card := range player.Hand {
+				fmt.Printf("  %d. %s\n", j+1, card.Card.ZhName)
+			}
+			fmt.Print("\n输入要替换的卡牌编号 (空格分隔, 直接回车保留全部): ")
+		} else {
+			fmt.Printf("\n=== Mulligan: %s ===\n", []string{"First", "Second"}[i])
+			for j, card := range player.Hand {
+				fmt.Printf("  %d. %s\n", j+1, card.Card.Name)
+			}
+			fmt.Print("\nEnter card numbers to replace (space separated, empty to keep all): ")
+		}
+
+		if !scanner.Scan() {
+			return false
+		}
+
+		// Parse card numbers
+		indices := []int{}
+		for _, part := range strings.Fields(scanner.Text()) {
+			cardNum, err := strconv.Atoi(part)
+			if err != nil {
+				fmt.Println("Error: Invalid card number")
+				return true
+			}
+			indices = append(indices, cardNum-1)
+		}
+
+		if err := e.SubmitMulligan(player, indices); err != nil {
+			fmt.Printf("Error submitting mulligan: %v\n", err)
+		}
+		return true
+	}
+
+	return true
+}
+
 func handlePlayCard(e *engine.Engine, g *game.Game, parts []string) {
 	if len(parts) < 2 {
 		fmt.Println("Error: Please specify a card number")
@@ -267,21 +455,42 @@ func handlePlayCard(e *engine.Engine, g *game.Game, parts []string) {
 
 	// Default position is -1 (auto-position)
 	position := -1
+	var target *game.Entity = nil
+	chooseOne := 0
 
-	// If position is specified and the card is a minion
-	if len(parts) >= 3 && card.Card.Type == game.Minion {
-		pos, err := strconv.Atoi(parts[2])
-		if err == nil && pos > 0 && pos <= len(g.CurrentPlayer.Field)+1 {
-			position = pos - 1
+	// Optional arguments: a field position for minions, a target like e1 and a choice like c2
+	for _, arg := range parts[2:] {
+		switch {
+		case strings.HasPrefix(arg, "c"):
+			choice, err := strconv.Atoi(arg[1:])
+			if err != nil || choice < 1 || choice > len(card.Card.ChooseOne) {
+				fmt.Printf("Error: Invalid choice %q\n", arg)
+				return
+			}
+			chooseOne = choice - 1
+		case strings.HasPrefix(arg, "e") || strings.HasPrefix(arg, "f"):
+			target, err = parseTarget(g, arg)
+			if err != nil {
+				fmt.Printf("Error: %v\n", err)
+				return
+			}
+		default:
+			pos, err := strconv.Atoi(arg)
+			if err == nil && card.Card.Type == game.Minion && pos > 0 && pos <= len(g.CurrentPlayer.Field)+1 {
+				position = pos - 1
+			}
 		}
 	}
 
-	// For now, we don't handle targeting or choose one effects
-	var target *game.Entity = nil
-	chooseOne := 0
-
 	// Play the card
-	err = e.PlayCard(g.CurrentPlayer, handIndex, target, position, chooseOne)
+	err = e.PerformPlayerAction(game.Action{
+		Type:      game.ActionPlayCard,
+		Player:    g.CurrentPlayer,
+		HandIndex: handIndex,
+		Target:    target,
+		Position:  position,
+		ChooseOne: chooseOne,
+	})
 	if err != nil {
 		fmt.Printf("Error playing card: %v\n", err)
 		return
@@ -314,8 +523,8 @@ func handleAttack(e *engine.Engine, g *game.Game, parts []string) {
 	attackerIndex := attackerNum - 1
 	defenderIndex := defenderNum - 1
 
-	// Validate attacker index
-	if attackerIndex < 0 || attackerIndex >= len(g.CurrentPlayer.Field) {
+	// Validate attacker index, -1 is the hero
+	if attackerIndex < -1 || attackerIndex >= len(g.CurrentPlayer.Field) {
 		fmt.Println("Error: Attacker number out of range")
 		return
 	}
@@ -323,18 +532,29 @@ func handleAttack(e *engine.Engine, g *game.Game, parts []string) {
 	// Get the opponent
 	opponent := g.Players[1-g.CurrentPlayerIndex]
 
-	// Validate defender index
-	if defenderIndex < 0 || defenderIndex >= len(opponent.Field) {
+	// Validate defender index, -1 is the hero
+	if defenderIndex < -1 || defenderIndex >= len(opponent.Field) {
 		fmt.Println("Error: Defender number out of range")
 		return
 	}
 
 	// Get the entities for attack
-	attacker := g.CurrentPlayer.Field[attackerIndex]
-	defender := opponent.Field[defenderIndex]
+	attacker := g.CurrentPlayer.Hero
+	if attackerIndex >= 0 {
+		attacker = g.CurrentPlayer.Field[attackerIndex]
+	}
+	defender := opponent.Hero
+	if defenderIndex >= 0 {
+		defender = opponent.Field[defenderIndex]
+	}
 
 	// Perform the attack
-	err = g.Attack(attacker, defender, false)
+	err = e.PerformPlayerAction(game.Action{
+		Type:   game.ActionAttack,
+		Player: g.CurrentPlayer,
+		Source: attacker,
+		Target: defender,
+	})
 	if err != nil {
 		fmt.Printf("Error performing attack: %v\n", err)
 		return
@@ -342,3 +562,95 @@ func handleAttack(e *engine.Engine, g *game.Game, parts []string) {
 
 	fmt.Printf("%s attacked %s successfully!\n", attacker.Card.Name, defender.Card.Name)
 }
+
+func handleHeroPower(e *engine.Engine, g *game.Game, parts []string) {
+	if g.CurrentPlayer.HeroPower == nil {
+		fmt.Println("Error: No hero power")
+		return
+	}
+
+	// Parse optional target
+	var target *game.Entity
+	if len(parts) >= 2 {
+		var err error
+		target, err = parseTarget(g, parts[1])
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	err := e.PerformPlayerAction(game.Action{
+		Type:   game.ActionHeroPower,
+		Player: g.CurrentPlayer,
+		Source: g.CurrentPlayer.HeroPower,
+		Target: target,
+	})
+	if err != nil {
+		fmt.Printf("Error using hero power: %v\n", err)
+		return
+	}
+
+	fmt.Printf("Used %s successfully!\n", g.CurrentPlayer.HeroPower.Card.Name)
+}
+
+func handleChoose(e *engine.Engine, g *game.Game, parts []string) {
+	if len(parts) < 2 {
+		fmt.Println("Error: Please specify an option number")
+		return
+	}
+
+	num, err := strconv.Atoi(parts[1])
+	if err != nil {
+		fmt.Println("Error: Invalid option number")
+		return
+	}
+
+	// The player with the pending choice answers it
+	player := g.CurrentPlayer
+	for _, p := range g.Players {
+		if p.PendingChoice != nil && p.PendingChoice.Type == game.ChoiceDiscover {
+			player = p
+		}
+	}
+
+	err = e.PerformPlayerAction(game.Action{
+		Type:   game.ActionChoose,
+		Player: player,
+		Choice: num - 1,
+	})
+	if err != nil {
+		fmt.Printf("Error choosing: %v\n", err)
+	}
+}
+
+// parseTarget parses a character reference such as e0 (enemy hero), e2 (second enemy minion),
+// f0 (your hero) or f1 (your first minion)
+func parseTarget(g *game.Game, s string) (*game.Entity, error) {
+	if len(s) < 2 {
+		return nil, fmt.Errorf("invalid target %q", s)
+	}
+
+	var player *game.Player
+	switch s[0] {
+	case 'e':
+		player = g.Players[1-g.CurrentPlayerIndex]
+	case 'f':
+		player = g.CurrentPlayer
+	default:
+		return nil, fmt.Errorf("invalid target %q", s)
+	}
+
+	num, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid target %q", s)
+	}
+
+	if num == 0 {
+		return player.Hero, nil
+	}
+	if num < 0 || num > len(player.Field) {
+		return nil, fmt.Errorf("target %q out of range", s)
+	}
+	return player.Field[num-1], nil
+}
@@ -17,8 +17,11 @@ type GameState struct {
 	CurrentTurn        int                  `json:"currentTurn"`
 	Phase              string               `json:"phase"`
 	CurrentPlayerIndex int                  `json:"currentPlayerIndex"`
+	Seed               int64                `json:"seed"`
 	Players            []*SimplifiedPlayer  `json:"players"`
 	Actions            []string             `json:"availableActions"`
+	GameOver           bool                 `json:"gameOver"`
+	Winner             int                  `json:"winner"` // Index of the winning player, -1 for none or a draw
 }
 
 // SimplifiedPlayer represents the player state for the frontend
@@ -29,6 +32,11 @@ type SimplifiedPlayer struct {
 	Mana      int                 `json:"mana"`
 	TotalMana int                 `json:"totalMana"`
 	Weapon    *SimplifiedEntity   `json:"weapon,omitempty"`
+	HeroPower *SimplifiedEntity   `json:"heroPower,omitempty"`
+	Secrets   []*SimplifiedEntity `json:"secrets"`
+	Discover  []*SimplifiedEntity `json:"discover,omitempty"` // Cards offered by a pending Discover
+	Mulligan  bool                `json:"mulliganPending"`
+	PlayState string              `json:"playState"`
 }
 
 // SimplifiedEntity represents a card entity for the frontend
@@ -36,11 +44,16 @@ type SimplifiedEntity struct {
 	Name        string   `json:"name"`
 	Attack      int      `json:"attack"`
 	Health      int      `json:"health"`
+	Armor       int      `json:"armor,omitempty"`
 	Cost        int      `json:"cost"`
 	Type        string   `json:"type"`
 	Description string   `json:"description"`
 	Tags        []string `json:"tags"`
 	CanAttack   bool     `json:"canAttack"`
+	Playable    bool     `json:"playable"`
+	Targeted    bool     `json:"targeted"`
+	Choices     []string `json:"choices,omitempty"`
+	CostsHealth bool     `json:"costsHealth,omitempty"`
 }
 
 var (
@@ -109,10 +122,15 @@ func actionHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var action struct {
-		Type      string `json:"type"`
-		CardIndex int    `json:"cardIndex"`
-		Position  int    `json:"position"`
-		Target    int    `json:"target"`
+		Type       string `json:"type"`
+		CardIndex  int    `json:"cardIndex"`
+		Position   int    `json:"position"`
+		ChooseOne  int    `json:"chooseOne"`
+		Target     int    `json:"target"`
+		TargetSide string `json:"targetSide"` // "opponent" or "player", target -1 is the hero
+		Player     int    `json:"player"`
+		Indices    []int  `json:"indices"`
+		Choice     int    `json:"choice"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&action); err != nil {
@@ -123,30 +141,78 @@ func actionHandler(w http.ResponseWriter, r *http.Request) {
 	var err error
 	switch action.Type {
 	case "playCard":
-		// Use proper method available in engine with the correct parameters
-		err = gameEngine.PlayCard(gameObj.CurrentPlayer, action.CardIndex, nil, action.Position, 0)
+		var target *game.Entity
+		target, err = resolveTarget(gameObj, action.TargetSide, action.Target)
+		if err == nil {
+			err = gameEngine.PerformPlayerAction(game.Action{
+				Type:      game.ActionPlayCard,
+				Player:    gameObj.CurrentPlayer,
+				HandIndex: action.CardIndex,
+				Target:    target,
+				Position:  action.Position,
+				ChooseOne: action.ChooseOne,
+			})
+		}
 	case "attack":
 		// Fix: Use proper Attack method signature
 		var attacker, target *game.Entity
 		
-		// Get attacker from current player's field
-		if action.CardIndex >= 0 && action.CardIndex < len(gameObj.CurrentPlayer.Field) {
+		// Get attacker from current player's field, -1 is the hero
+		if action.CardIndex == -1 {
+			attacker = gameObj.CurrentPlayer.Hero
+		} else if action.CardIndex >= 0 && action.CardIndex < len(gameObj.CurrentPlayer.Field) {
 			attacker = gameObj.CurrentPlayer.Field[action.CardIndex]
 		}
 		
-		// Get target from opponent's field
+		// Get target from opponent's field, -1 is the hero
 		opponent := gameObj.Players[1-gameObj.CurrentPlayerIndex]
-		if action.Target >= 0 && action.Target < len(opponent.Field) {
+		if action.Target == -1 {
+			target = opponent.Hero
+		} else if action.Target >= 0 && action.Target < len(opponent.Field) {
 			target = opponent.Field[action.Target]
 		}
 		
 		if attacker != nil && target != nil {
-			err = gameEngine.Attack(attacker, target, false)
+			err = gameEngine.PerformPlayerAction(game.Action{
+				Type:   game.ActionAttack,
+				Player: gameObj.CurrentPlayer,
+				Source: attacker,
+				Target: target,
+			})
 		} else {
 			err = fmt.Errorf("invalid attacker or target")
 		}
+	case "heroPower":
+		var target *game.Entity
+		target, err = resolveTarget(gameObj, action.TargetSide, action.Target)
+		if err == nil {
+			err = gameEngine.PerformPlayerAction(game.Action{
+				Type:   game.ActionHeroPower,
+				Player: gameObj.CurrentPlayer,
+				Source: gameObj.CurrentPlayer.HeroPower,
+				Target: target,
+			})
+		}
+	case "choose":
+		if action.Player >= 0 && action.Player < len(gameObj.Players) {
+			err = gameEngine.PerformPlayerAction(game.Action{
+				Type:   game.ActionChoose,
+				Player: gameObj.Players[action.Player],
+				Choice: action.Choice,
+			})
+		} else {
+			err = fmt.Errorf("invalid player")
+		}
 	case "endTurn":
-		err = gameEngine.EndPlayerTurn()
+		err = gameEngine.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: gameObj.CurrentPlayer})
+	case "concede":
+		err = gameEngine.PerformPlayerAction(game.Action{Type: game.ActionConcede, Player: gameObj.CurrentPlayer})
+	case "mulligan":
+		if action.Player >= 0 && action.Player < len(gameObj.Players) {
+			err = gameEngine.SubmitMulligan(gameObj.Players[action.Player], action.Indices)
+		} else {
+			err = fmt.Errorf("invalid player")
+		}
 	default:
 		http.Error(w, "Invalid action type", http.StatusBadRequest)
 		return
@@ -171,22 +237,58 @@ func convertGameState(g *game.Game) *GameState {
 		CurrentTurn:        g.CurrentTurn,
 		Phase:              g.Phase.String(),
 		CurrentPlayerIndex: g.CurrentPlayerIndex,
+		Seed:               g.Seed,
 		Players:            make([]*SimplifiedPlayer, len(g.Players)),
-		Actions:            []string{"playCard", "attack", "endTurn"},
+		Actions:            []string{"playCard", "attack", "heroPower", "choose", "endTurn", "concede"},
+	}
+
+	if g.Phase == game.BeginMulligan {
+		gameState.Actions = []string{"mulligan"}
+	}
+
+	gameState.Winner = -1
+	if g.IsGameOver() {
+		gameState.GameOver = true
+		gameState.Actions = []string{}
+		for i, player := range g.Players {
+			if player == g.Winner() {
+				gameState.Winner = i
+			}
+		}
+	}
+
+	// Collect which entities the current player can use right now
+	canAttack := make(map[*game.Entity]bool)
+	playable := make(map[int]bool)
+	heroPowerUsable := false
+	for _, action := range g.LegalActions(g.CurrentPlayer) {
+		switch action.Type {
+		case game.ActionAttack:
+			canAttack[action.Source] = true
+		case game.ActionPlayCard:
+			playable[action.HandIndex] = true
+		case game.ActionHeroPower:
+			heroPowerUsable = true
+		}
 	}
 
 	// Convert both players
 	for i, player := range g.Players {
 		simplifiedPlayer := &SimplifiedPlayer{
 			Hero: &SimplifiedEntity{
-				Name:   player.Hero.Card.Name,
-				Health: player.Hero.Health,
-				Type:   "Hero",
+				Name:      player.Hero.Card.Name,
+				Attack:    g.EffectiveAttack(player.Hero),
+				Health:    player.Hero.Health,
+				Armor:     player.Hero.Armor,
+				Type:      "Hero",
+				CanAttack: canAttack[player.Hero],
 			},
 			Hand:      make([]*SimplifiedEntity, len(player.Hand)),
 			Field:     make([]*SimplifiedEntity, len(player.Field)),
 			Mana:      player.Mana,
 			TotalMana: player.TotalMana,
+			Mulligan:  player.PendingChoice != nil && player.PendingChoice.Type == game.ChoiceMulligan,
+			PlayState: player.PlayState.String(),
 		}
 
 		// Convert hand
@@ -195,25 +297,27 @@ func convertGameState(g *game.Game) *GameState {
 				Name:        card.Card.Name,
 				Attack:      card.Attack,
 				Health:      card.Health,
-				Cost:        card.Card.Cost,
+				Cost:        g.EffectiveCost(card),
 				Type:        card.Card.Type.String(),
 				Description: card.Card.Description,
 				Tags:        convertTagsToString(card.Tags),
+				Playable:    playable[j] && player == g.CurrentPlayer,
+				Choices:     chooseOneNames(card.Card),
+				CostsHealth: game.CostsHealth(card),
 			}
 		}
 
 		// Convert field
 		for j, card := range player.Field {
-			canAttack := !card.Exhausted && card.NumAttackThisTurn < getMaxAttacksPerTurn(card.Tags)
 			simplifiedPlayer.Field[j] = &SimplifiedEntity{
 				Name:        card.Card.Name,
-				Attack:      card.Attack,
+				Attack:      g.EffectiveAttack(card),
 				Health:      card.Health,
 				Cost:        card.Card.Cost,
 				Type:        card.Card.Type.String(),
 				Description: card.Card.Description,
 				Tags:        convertTagsToString(card.Tags),
-				CanAttack:   canAttack && i == g.CurrentPlayerIndex,
+				CanAttack:   canAttack[card],
 			}
 		}
 
@@ -229,12 +333,85 @@ func convertGameState(g *game.Game) *GameState {
 			}
 		}
 
+		// Convert the options of a pending Discover
+		if choice := player.PendingChoice; choice != nil && choice.Type == game.ChoiceDiscover {
+			simplifiedPlayer.Discover = make([]*SimplifiedEntity, len(choice.Options))
+			for j, option := range choice.Options {
+				simplifiedPlayer.Discover[j] = &SimplifiedEntity{
+					Name:        option.Card.Name,
+					Attack:      option.Attack,
+					Health:      option.Health,
+					Cost:        option.Cost,
+					Type:        option.Card.Type.String(),
+					Description: option.Card.Description,
+					Tags:        convertTagsToString(option.Tags),
+				}
+			}
+		}
+
+		// Convert secrets
+		simplifiedPlayer.Secrets = make([]*SimplifiedEntity, len(player.Secrets))
+		for j, secret := range player.Secrets {
+			simplifiedPlayer.Secrets[j] = &SimplifiedEntity{
+				Name:        secret.Card.Name,
+				Cost:        secret.Card.Cost,
+				Type:        secret.Card.Type.String(),
+				Description: secret.Card.Description,
+			}
+		}
+
+		// Add hero power if exists
+		if player.HeroPower != nil {
+			simplifiedPlayer.HeroPower = &SimplifiedEntity{
+				Name:        player.HeroPower.Card.Name,
+				Cost:        g.EffectiveCost(player.HeroPower),
+				Type:        "Hero Power",
+				Description: player.HeroPower.Card.Description,
+				Playable:    heroPowerUsable && player == g.CurrentPlayer,
+				Targeted:    player.HeroPower.Card.TakesTarget(),
+			}
+		}
+
 		gameState.Players[i] = simplifiedPlayer
 	}
 
 	return gameState
 }
 
+// chooseOneNames lists the names of the options of a Choose One card
+func chooseOneNames(card *game.Card) []string {
+	names := make([]string, 0, len(card.ChooseOne))
+	for _, option := range card.ChooseOne {
+		names = append(names, option.Name)
+	}
+	return names
+}
+
+// resolveTarget finds the character an action targets
+// side is "opponent" or "player" relative to the current player, an empty side means no target
+// index -1 stands for the hero, other values index the field
+func resolveTarget(g *game.Game, side string, index int) (*game.Entity, error) {
+	var player *game.Player
+	switch side {
+	case "":
+		return nil, nil
+	case "opponent":
+		player = g.Players[1-g.CurrentPlayerIndex]
+	case "player":
+		player = g.CurrentPlayer
+	default:
+		return nil, fmt.Errorf("invalid target side: %s", side)
+	}
+
+	if index == -1 {
+		return player.Hero, nil
+	}
+	if index < 0 || index >= len(player.Field) {
+		return nil, fmt.Errorf("invalid target")
+	}
+	return player.Field[index], nil
+}
+
 // Fix: Change to handle game.Tag type properly
 func convertTagsToString(tags []game.Tag) []string {
 	result := make([]string, len(tags))
@@ -253,19 +430,11 @@ func convertTagsToString(tags []game.Tag) []string {
 			result[i] = "Windfury"
 		case game.TAG_POISONOUS:
 			result[i] = "Poisonous"
+		case game.TAG_COSTS_HEALTH:
+			result[i] = "Costs Health"
 		default:
 			result[i] = fmt.Sprintf("Tag(%d)", int(tag.Type))
 		}
 	}
 	return result
 }
-
-// Fix: Use proper TagType check
-func getMaxAttacksPerTurn(tags []game.Tag) int {
-	for _, tag := range tags {
-		if tag.Type == game.TAG_WINDFURY {
-			return 2
-		}
-	}
-	return 1
-} 
\ No newline at end of file
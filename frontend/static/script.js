@@ -1,10 +1,14 @@
 // Game state and UI variables
 let gameState = null;
 let selectedCard = null;
+let selectedChoice = 0; // Chosen option of a Choose One card
 let selectedMinion = null;
 let isAttacking = false;
+let isTargetingHeroPower = false;
 let prevPlayerHandCount = 0;
 let prevOpponentHandCount = 0;
+let mulliganPlayer = null;
+let mulliganSelection = [];
 
 // Card type icons
 const cardIcons = {
@@ -21,7 +25,17 @@ document.addEventListener('DOMContentLoaded', () => {
     fetchGameState();
     
     // Set up event listeners
-    document.getElementById('end-turn').addEventListener('click', endTurn);
+    document.getElementById('concede').addEventListener('click', concede);
+    document.getElementById('hero-power').addEventListener('click', handleHeroPowerClick);
+    document.getElementById('player-hero').addEventListener('click', () => handleHeroClick('player'));
+    document.getElementById('opponent-hero').addEventListener('click', () => handleHeroClick('opponent'));
+    document.getElementById('end-turn').addEventListener('click', () => {
+        if (mulliganPlayer !== null) {
+            submitMulligan();
+        } else {
+            endTurn();
+        }
+    });
     
     // Poll for game state updates every 3 seconds (for demo purposes)
     // In a real game, you'd use websockets for real-time updates
@@ -55,6 +69,43 @@ function updateUI() {
     document.getElementById('turn-info').textContent = `Turn: ${gameState.currentTurn}`;
     document.getElementById('phase-info').textContent = `Phase: ${gameState.phase}`;
     
+    // Show the result once the game is over
+    if (gameState.gameOver) {
+        const result = gameState.winner === -1 ? 'Draw' : `Player ${gameState.winner + 1} wins`;
+        document.getElementById('phase-info').textContent = `Game Over: ${result}`;
+        logMessage(`Game over: ${result}.`);
+    }
+    
+    // During mulligan, show the hand of the next player who has to decide
+    // The selection is kept across refreshes until the deciding player changes
+    const previousMulliganPlayer = mulliganPlayer;
+    mulliganPlayer = null;
+    document.getElementById('end-turn').textContent = 'End Turn';
+    if (gameState.phase === 'Begin Mulligan') {
+        mulliganPlayer = gameState.players.findIndex(p => p.mulliganPending);
+        if (mulliganPlayer !== previousMulliganPlayer) {
+            mulliganSelection = [];
+        }
+        if (mulliganPlayer !== -1) {
+            updateMulliganHand(gameState.players[mulliganPlayer].hand);
+            document.getElementById('end-turn').textContent = 'Confirm';
+            logMessage(`Player ${mulliganPlayer + 1}: select cards to replace, then confirm.`);
+            return;
+        }
+        mulliganPlayer = null;
+    }
+    if (mulliganPlayer === null) {
+        mulliganSelection = [];
+    }
+    
+    // A pending Discover replaces the hand until a card is picked
+    const discoverPlayer = gameState.players.findIndex(p => p.discover && p.discover.length > 0);
+    if (discoverPlayer !== -1) {
+        updateDiscover(discoverPlayer, gameState.players[discoverPlayer].discover);
+        logMessage(`Player ${discoverPlayer + 1}: discover a card.`);
+        return;
+    }
+    
     // Get player and opponent based on current player index
     const currentPlayerIdx = gameState.currentPlayerIndex;
     const player = gameState.players[currentPlayerIdx];
@@ -62,11 +113,24 @@ function updateUI() {
     
     // Update player hero
     document.getElementById('player-hero-name').textContent = player.hero.name;
-    document.getElementById('player-hero-health').textContent = `${player.hero.health} HP`;
+    document.getElementById('player-hero-health').textContent = formatHeroHealth(player.hero);
     
     // Update opponent hero
     document.getElementById('opponent-hero-name').textContent = opponent.hero.name;
-    document.getElementById('opponent-hero-health').textContent = `${opponent.hero.health} HP`;
+    document.getElementById('opponent-hero-health').textContent = formatHeroHealth(opponent.hero);
+    
+    // Update secrets, only the count of the opponent's secrets is shown
+    const playerSecrets = player.secrets || [];
+    const opponentSecrets = opponent.secrets || [];
+    document.getElementById('player-secrets').textContent = playerSecrets.length
+        ? `Secrets: ${playerSecrets.map(secret => secret.name).join(', ')}`
+        : '';
+    document.getElementById('opponent-secrets').textContent = opponentSecrets.length
+        ? `Secrets: ${'?'.repeat(opponentSecrets.length)}`
+        : '';
+    
+    // Update hero power button
+    updateHeroPower(player.heroPower);
     
     // Update mana displays
     document.getElementById('player-mana').textContent = `${player.mana}/${player.totalMana}`;
@@ -125,6 +189,86 @@ function updateUI() {
     selectedCard = null;
     selectedMinion = null;
     isAttacking = false;
+    isTargetingHeroPower = false;
+    document.getElementById('hero-power').classList.remove('targeting');
+}
+
+// Format hero health with armor and attack if the hero has any
+function formatHeroHealth(hero) {
+    let text = `${hero.health} HP`;
+    if (hero.armor) {
+        text += ` + ${hero.armor} Armor`;
+    }
+    if (hero.attack) {
+        text = `${hero.attack} ATK / ${text}`;
+    }
+    return text;
+}
+
+// Update the hero power button
+function updateHeroPower(heroPower) {
+    const button = document.getElementById('hero-power');
+    if (!heroPower) {
+        button.textContent = 'Hero Power';
+        button.disabled = true;
+        return;
+    }
+    button.textContent = `${heroPower.name} (${heroPower.cost})`;
+    button.title = heroPower.description;
+    button.disabled = !heroPower.playable;
+}
+
+// Show the opening hand during mulligan, clicking a card toggles replacing it
+function updateMulliganHand(cards) {
+    const container = document.getElementById('player-hand');
+    container.innerHTML = '';
+    
+    cards.forEach((card, index) => {
+        const cardElement = createCardElement(card, index);
+        const newElement = cardElement.cloneNode(true);
+        if (mulliganSelection.includes(index)) {
+            newElement.style.opacity = '0.5';
+        }
+        newElement.addEventListener('click', () => {
+            const pos = mulliganSelection.indexOf(index);
+            if (pos === -1) {
+                mulliganSelection.push(index);
+                newElement.style.opacity = '0.5';
+            } else {
+                mulliganSelection.splice(pos, 1);
+                newElement.style.opacity = '';
+            }
+        });
+        container.appendChild(newElement);
+    });
+}
+
+// Show the cards of a pending Discover, clicking one picks it
+function updateDiscover(playerIndex, cards) {
+    const container = document.getElementById('player-hand');
+    container.innerHTML = '';
+    
+    cards.forEach((card, index) => {
+        const cardElement = createCardElement(card, index).cloneNode(true);
+        cardElement.addEventListener('click', () => {
+            sendAction({
+                type: 'choose',
+                player: playerIndex,
+                choice: index
+            });
+        });
+        container.appendChild(cardElement);
+    });
+}
+
+// Submit the selected cards to replace
+function submitMulligan() {
+    sendAction({
+        type: 'mulligan',
+        player: mulliganPlayer,
+        indices: mulliganSelection
+    });
+    mulliganSelection = [];
 }
 
 // Update the player's hand display
@@ -203,6 +347,9 @@ function createCardElement(card, index) {
     
     const cardCost = cardElement.querySelector('.card-cost');
     cardCost.textContent = card.cost;
+    if (card.costsHealth) {
+        cardCost.classList.add('costs-health');
+    }
     
     const cardDescription = cardElement.querySelector('.card-description');
     cardDescription.textContent = card.description || '';
@@ -219,6 +366,11 @@ function createCardElement(card, index) {
         cardHealth.textContent = card.health;
     }
     
+    // Highlight cards that can be played right now
+    if (card.playable) {
+        cardElement.classList.add('playable');
+    }
+    
     // Add click event for player's cards
     cardElement.addEventListener('click', () => handleCardClick(index));
     
@@ -281,12 +433,23 @@ function handleCardClick(index) {
         }
     }
     
+    // Choose One cards ask which option to use first
+    const card = gameState.players[gameState.currentPlayerIndex].hand[index];
+    selectedChoice = 0;
+    if (card.choices && card.choices.length > 0) {
+        const choice = chooseOption(card.choices);
+        if (choice === null) {
+            selectedCard = null;
+            return;
+        }
+        selectedChoice = choice;
+    }
+    
     // Select new card
     selectedCard = index;
     cardElement.style.transform = 'translateY(-20px)';
     
     // For minions, we need to choose a position
-    const card = gameState.players[gameState.currentPlayerIndex].hand[index];
     if (card.type === 'Minion') {
         logMessage('Select a position on the board to play this minion.');
     } else {
@@ -295,8 +458,75 @@ function handleCardClick(index) {
     }
 }
 
+// Handle click on the hero power button
+function handleHeroPowerClick() {
+    const heroPower = gameState.players[gameState.currentPlayerIndex].heroPower;
+    if (!heroPower || !heroPower.playable) return;
+    
+    // Hero powers without a target are used right away
+    if (!heroPower.targeted) {
+        useHeroPower();
+        return;
+    }
+    
+    // Toggle target selection
+    isTargetingHeroPower = !isTargetingHeroPower;
+    document.getElementById('hero-power').classList.toggle('targeting', isTargetingHeroPower);
+    if (isTargetingHeroPower) {
+        logMessage('Select a target for your hero power.');
+    } else {
+        logMessage('Hero power cancelled.');
+    }
+}
+
+// Handle click on a hero portrait
+function handleHeroClick(side) {
+    if (isTargetingHeroPower) {
+        useHeroPower(side, -1);
+        return;
+    }
+    
+    // Attack the opponent hero with the selected attacker
+    if (side === 'opponent') {
+        if (isAttacking && selectedMinion !== null) {
+            attack(selectedMinion, -1);
+        }
+        return;
+    }
+    
+    // Select our own hero as the attacker, -1 stands for the hero
+    const hero = gameState.players[gameState.currentPlayerIndex].hero;
+    if (!hero.canAttack) {
+        return;
+    }
+    const heroElement = document.getElementById('player-hero');
+    if (selectedMinion === -1 && isAttacking) {
+        heroElement.classList.remove('selected');
+        selectedMinion = null;
+        isAttacking = false;
+        logMessage('Attack cancelled.');
+        return;
+    }
+    if (selectedMinion !== null) {
+        const prevMinionElement = document.querySelector(`#player-field .minion[data-index="${selectedMinion}"]`);
+        if (prevMinionElement) {
+            prevMinionElement.classList.remove('selected');
+        }
+    }
+    selectedMinion = -1;
+    isAttacking = true;
+    heroElement.classList.add('selected');
+    logMessage('Select a target to attack.');
+}
+
 // Handle click on player's minion
 function handlePlayerMinionClick(index) {
+    // Use the hero power on the minion if we're choosing its target
+    if (isTargetingHeroPower) {
+        useHeroPower('player', index);
+        return;
+    }
+    
     const minion = gameState.players[gameState.currentPlayerIndex].field[index];
     const minionElement = document.querySelector(`#player-field .minion[data-index="${index}"]`);
     
@@ -321,7 +551,9 @@ function handlePlayerMinionClick(index) {
         }
         
         // Deselect previous minion if any
-        if (selectedMinion !== null) {
+        if (selectedMinion === -1) {
+            document.getElementById('player-hero').classList.remove('selected');
+        } else if (selectedMinion !== null) {
             const prevMinionElement = document.querySelector(`#player-field .minion[data-index="${selectedMinion}"]`);
             if (prevMinionElement) {
                 prevMinionElement.classList.remove('selected');
@@ -338,6 +570,12 @@ function handlePlayerMinionClick(index) {
 
 // Handle click on opponent's minion
 function handleOpponentMinionClick(index) {
+    // Use the hero power on the minion if we're choosing its target
+    if (isTargetingHeroPower) {
+        useHeroPower('opponent', index);
+        return;
+    }
+    
     // If we're not attacking, do nothing
     if (!isAttacking || selectedMinion === null) {
         return;
@@ -347,6 +585,17 @@ function handleOpponentMinionClick(index) {
     attack(selectedMinion, index);
 }
 
+// Ask which option of a Choose One card to use, returns null if cancelled
+function chooseOption(choices) {
+    const list = choices.map((name, i) => `${i + 1}. ${name}`).join('\n');
+    const answer = window.prompt(`Choose One:\n${list}`, '1');
+    const choice = parseInt(answer, 10);
+    if (isNaN(choice) || choice < 1 || choice > choices.length) {
+        return null;
+    }
+    return choice - 1;
+}
+
 // Play a card from hand
 function playCard(cardIndex, position = null) {
     // Reset selections
@@ -356,8 +605,10 @@ function playCard(cardIndex, position = null) {
     const actionData = {
         type: 'playCard',
         cardIndex: cardIndex,
-        position: position !== null ? position : -1
+        position: position !== null ? position : -1,
+        chooseOne: selectedChoice
     };
+    selectedChoice = 0;
     
     // Send action to server
     sendAction(actionData);
@@ -374,6 +625,7 @@ function attack(attackerIndex, targetIndex) {
     if (minionElement) {
         minionElement.classList.remove('selected');
     }
+    document.getElementById('player-hero').classList.remove('selected');
     
     // Prepare action data
     const actionData = {
@@ -386,6 +638,18 @@ function attack(attackerIndex, targetIndex) {
     sendAction(actionData);
 }
 
+// Use the hero power, side and index are only needed for targeted hero powers
+function useHeroPower(side = '', index = -1) {
+    isTargetingHeroPower = false;
+    document.getElementById('hero-power').classList.remove('targeting');
+    
+    sendAction({
+        type: 'heroPower',
+        targetSide: side,
+        target: index
+    });
+}
+
 // End the current player's turn
 function endTurn() {
     // Reset selections
@@ -402,6 +666,13 @@ function endTurn() {
     sendAction(actionData);
 }
 
+// Concede the game for the current player
+function concede() {
+    sendAction({
+        type: 'concede'
+    });
+}
+
 // Send an action to the server
 function sendAction(actionData) {
     fetch('/api/action', {
@@ -1,9 +1,17 @@
 package engine
 
 import (
+	"errors"
+
 	"github.com/openhs/internal/game"
 )
 
 func (e *Engine) Attack(attacker *game.Entity, defender *game.Entity, skipValidation bool) error {
-	return e.game.Attack(attacker, defender, skipValidation)
+	if e.game.IsGameOver() {
+		return errors.New("game is over")
+	}
+
+	err := e.game.Attack(attacker, defender, skipValidation)
+	e.CheckGameOver()
+	return err
 }
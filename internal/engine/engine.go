@@ -7,6 +7,9 @@ import (
 	"github.com/openhs/internal/logger"
 )
 
+// TheCoinCardName is the card given to the second player at the start of the game
+const TheCoinCardName = "The Coin"
+
 // Engine handles the game rules and mechanics
 type Engine struct {
 	game      *game.Game
@@ -95,14 +98,31 @@ func (e *Engine) ProcessNextPhase() error {
 		return err
 	}
 
-	// Process the next phase if autoRun is enabled
-	if e.autoRun && e.game.Phase != game.MainAction && e.game.Phase != game.FinalGameover {
+	// A hero may have died during this phase, e.g. from fatigue or turn triggers
+	if e.game.Phase != game.FinalWrapup && e.game.Phase != game.FinalGameover && e.CheckGameOver() {
+		return nil
+	}
+
+	// Process the next phase if autoRun is enabled and no player input is needed
+	if e.autoRun && !e.isWaitingForInput() {
 		return e.ProcessNextPhase()
 	}
 
 	return nil
 }
 
+// isWaitingForInput checks if the current phase needs player input before moving on
+func (e *Engine) isWaitingForInput() bool {
+	switch e.game.Phase {
+	case game.MainAction, game.FinalGameover:
+		return true
+	case game.BeginMulligan:
+		return e.game.HasPendingMulligan()
+	default:
+		return false
+	}
+}
+
 // ProcessUntil processes game phases until the specified phase
 func (e *Engine) ProcessUntil(phase game.GamePhase) error {
 	autoRunBackup := e.autoRun
@@ -133,9 +153,10 @@ func (e *Engine) beginFirst() error {
 func (e *Engine) beginShuffle() error {
 	logger.Debug("Phase: Begin Shuffle")
 
-	// Shuffle player decks
-	// This would involve shuffling the Deck slice for each player
-	// TODO: Implement deck shuffling logic
+	// Shuffle player decks with the game's random source
+	for _, player := range e.game.Players {
+		e.game.ShuffleDeck(player)
+	}
 
 	// Set next phase
 	e.nextPhase = game.BeginDraw
@@ -160,10 +181,7 @@ func (e *Engine) beginDraw() error {
 	}
 
 	// Set next phase based on whether to skip mulligan or not
-	// For now we'll always skip mulligan
-	// TODO: Add skipMulligan configuration to game config
-	skipMulligan := true
-	if skipMulligan {
+	if e.game.SkipMulligan {
 		e.nextPhase = game.MainBegin
 	} else {
 		e.nextPhase = game.BeginMulligan
@@ -175,12 +193,38 @@ func (e *Engine) beginDraw() error {
 func (e *Engine) beginMulligan() error {
 	logger.Debug("Phase: Begin Mulligan")
 
-	// Handle mulligan phase
-	// Players select cards to redraw
-	// TODO: Implement mulligan logic
+	// Offer each player their opening hand, the engine waits here
+	// until every player has submitted their mulligan
+	for _, player := range e.game.Players {
+		e.game.OfferMulligan(player)
+	}
+
+	// Next phase is set once all players have submitted
+	e.nextPhase = game.InvalidPhase
+	return nil
+}
+
+// SubmitMulligan replaces the cards at the given hand indices for a player
+// Once all players have submitted, the game continues to the first turn
+func (e *Engine) SubmitMulligan(player *game.Player, replaceIndices []int) error {
+	if e.game.Phase != game.BeginMulligan {
+		return errors.New("can only mulligan during mulligan phase")
+	}
+
+	if err := e.game.Mulligan(player, replaceIndices); err != nil {
+		return err
+	}
+
+	// Wait for the other players
+	if e.game.HasPendingMulligan() {
+		return nil
+	}
 
-	// Set next phase
 	e.nextPhase = game.MainBegin
+	if e.autoRun {
+		return e.ProcessNextPhase()
+	}
+
 	return nil
 }
 
@@ -195,13 +239,27 @@ func (e *Engine) mainBegin() error {
 	e.game.CurrentPlayer = e.game.Players[0]
 
 	// Give "The Coin" to second player
-	// TODO: Implement coin logic
+	if len(e.game.Players) >= 2 {
+		e.giveCoin(e.game.Players[1])
+	}
 
 	// Set next phase
 	e.nextPhase = game.MainReady
 	return nil
 }
 
+// giveCoin adds "The Coin" to the player's hand
+func (e *Engine) giveCoin(player *game.Player) {
+	card, err := game.GetCardManager().CreateCardInstance(TheCoinCardName)
+	if err != nil {
+		logger.Warn("The Coin is not registered, skipping")
+		return
+	}
+
+	coin := game.NewEntity(card, e.game, player)
+	e.game.AddEntityToHand(player, coin, -1)
+}
+
 func (e *Engine) mainReady() error {
 	logger.Debug("Phase: Main Ready")
 
@@ -225,6 +283,11 @@ func (e *Engine) mainReady() error {
 		minion.NumTurnInPlay++
 	}
 
+	// Hero power can be used again
+	if player.HeroPower != nil {
+		player.HeroPower.Exhausted = false
+	}
+
 	// Set next phase
 	e.nextPhase = game.MainStartTriggers
 	return nil
@@ -235,6 +298,12 @@ func (e *Engine) mainStartTriggers() error {
 
 	// Process start-of-turn triggers
 	if e.game.CurrentPlayer != nil {
+		// Effects lasting until this player's next turn wear off
+		e.game.ExpireNextTurnEffects(e.game.CurrentPlayer)
+
+		// Count down dormant minions before start-of-turn triggers, awakened minions join in
+		e.game.ProcessDormant(e.game.CurrentPlayer)
+
 		ctx := game.TriggerContext{
 			Game:         e.game,
 			SourceEntity: e.game.CurrentPlayer.Hero,
@@ -282,7 +351,7 @@ func (e *Engine) mainStart() error {
 	logger.Debug("Phase: Main Start")
 
 	// Process any destroyed entities and update auras
-	// TODO: Implement destruction and aura logic
+	e.game.ProcessDestroyAndUpdateAura()
 
 	// Set next phase
 	e.nextPhase = game.MainAction
@@ -350,7 +419,7 @@ func (e *Engine) mainCleanup() error {
 	logger.Debug("Phase: Main Cleanup")
 
 	// Clean up one-turn effects
-	// TODO: Implement cleanup logic
+	e.game.ExpireTurnEffects()
 
 	// Set next phase
 	e.nextPhase = game.MainNext
@@ -376,7 +445,13 @@ func (e *Engine) finalWrapup() error {
 	logger.Debug("Phase: Final Wrapup")
 
 	// Determine game result
-	// TODO: Implement game result logic
+	e.game.CheckHeroDeaths()
+
+	if winner := e.game.Winner(); winner != nil {
+		logger.Info("Game finished", logger.String("winner", winner.Hero.Card.Name))
+	} else {
+		logger.Info("Game finished in a draw")
+	}
 
 	// Set next phase
 	e.nextPhase = game.FinalGameover
@@ -395,40 +470,129 @@ func (e *Engine) EndPlayerTurn() error {
 	if e.game.Phase != game.MainAction {
 		return errors.New("can only end turn during action phase")
 	}
+	if e.game.HasPendingChoice() {
+		return errors.New("a choice must be made first")
+	}
 
 	e.nextPhase = game.MainEnd
 	return e.ProcessNextPhase()
 }
 
-// PerformPlayerAction processes a player's action during the action phase
-// This would handle playing cards, attacking, using hero power, etc.
-func (e *Engine) PerformPlayerAction() error {
+// PerformPlayerAction validates and applies a player's action
+// This is the single entry point for playing cards, attacking, using hero power, etc.
+func (e *Engine) PerformPlayerAction(action game.Action) error {
+	if action.Player == nil {
+		return errors.New("action has no player")
+	}
+
+	// Conceding is allowed at any time until the game is over
+	if action.Type == game.ActionConcede {
+		return e.Concede(action.Player)
+	}
+
 	if e.game.Phase != game.MainAction {
 		return errors.New("can only perform actions during action phase")
 	}
 
+	// A pending Discover is answered by its player, nothing else happens until then
+	if action.Type == game.ActionChoose {
+		return e.Choose(action.Player, action.Choice)
+	}
+	if e.game.HasPendingChoice() {
+		return errors.New("a choice must be made first")
+	}
+
+	if action.Player != e.game.CurrentPlayer {
+		return errors.New("can only perform actions on your own turn")
+	}
+
+	logger.Debug("Performing player action", logger.String("type", action.Type.String()))
+
 	// Process the action
-	// TODO: Implement action processing
+	switch action.Type {
+	case game.ActionPlayCard:
+		return e.PlayCard(action.Player, action.HandIndex, action.Target, action.Position, action.ChooseOne)
+	case game.ActionAttack:
+		if action.Source == nil || action.Source.Owner != action.Player {
+			return errors.New("attacker must be one of your characters")
+		}
+		return e.Attack(action.Source, action.Target, false)
+	case game.ActionHeroPower:
+		return e.UseHeroPower(action.Player, action.Target)
+	case game.ActionEndTurn:
+		return e.EndPlayerTurn()
+	default:
+		return errors.New("unknown action type")
+	}
+}
 
-	return nil
+// LegalActions delegates to Game.LegalActions
+func (e *Engine) LegalActions(player *game.Player) []game.Action {
+	return e.game.LegalActions(player)
 }
 
 // CheckGameOver checks if the game is over and transitions to the appropriate phase
 func (e *Engine) CheckGameOver() bool {
+	if e.game.Phase == game.FinalWrapup || e.game.Phase == game.FinalGameover {
+		return true
+	}
+
 	// Check if any player has lost
-	// TODO: Implement game over conditions
+	if !e.game.CheckHeroDeaths() {
+		return false
+	}
 
-	// If game is over, transition to final wrap up
-	// e.nextPhase = game.FinalWrapup
-	// e.ProcessNextPhase()
-	// return true
+	// Game is over, transition to final wrap up
+	e.nextPhase = game.FinalWrapup
+	if err := e.ProcessNextPhase(); err != nil {
+		logger.Error("Failed to finish game", logger.Err(err))
+	}
 
-	return false
+	return true
+}
+
+// Concede ends the game with the player losing
+func (e *Engine) Concede(player *game.Player) error {
+	if e.game.IsGameOver() {
+		return errors.New("game is already over")
+	}
+
+	e.game.Concede(player)
+	e.CheckGameOver()
+	return nil
 }
 
 // PlayCard delegates to Game.PlayCard
 func (e *Engine) PlayCard(player *game.Player, handIndex int, target *game.Entity, fieldPos int, chooseOne int) error {
-	return e.game.PlayCard(player, handIndex, target, fieldPos, chooseOne)
+	if e.game.IsGameOver() {
+		return errors.New("game is over")
+	}
+
+	err := e.game.PlayCard(player, handIndex, target, fieldPos, chooseOne)
+	e.CheckGameOver()
+	return err
+}
+
+// UseHeroPower delegates to Game.UseHeroPower
+func (e *Engine) UseHeroPower(player *game.Player, target *game.Entity) error {
+	if e.game.IsGameOver() {
+		return errors.New("game is over")
+	}
+
+	err := e.game.UseHeroPower(player, target)
+	e.CheckGameOver()
+	return err
+}
+
+// Choose delegates to Game.Choose
+func (e *Engine) Choose(player *game.Player, index int) error {
+	if e.game.IsGameOver() {
+		return errors.New("game is over")
+	}
+
+	err := e.game.Choose(player, index)
+	e.CheckGameOver()
+	return err
 }
 
 // AddEntityToField delegates to Game.AddEntityToField
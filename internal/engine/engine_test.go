@@ -412,3 +412,297 @@ func TestManaHandlingAcrossPlayerTurns(t *testing.T) {
 		t.Errorf("Expected player 2 TotalMana to remain 1, got %d", g.Players[1].TotalMana)
 	}
 }
+
+// TestMulligan tests that the engine waits in the mulligan phase until both players have submitted
+func TestMulligan(t *testing.T) {
+	g := game.CreateTestGame()
+	g.SkipMulligan = false
+	e := NewEngine(g)
+
+	err := e.StartGame()
+	if err != nil {
+		t.Fatalf("Failed to start game: %v", err)
+	}
+
+	// The engine should wait for the players' decisions
+	if g.Phase != game.BeginMulligan {
+		t.Fatalf("Expected phase to be BeginMulligan, got %v", g.Phase)
+	}
+	for i, player := range g.Players {
+		if player.PendingChoice == nil || player.PendingChoice.Type != game.ChoiceMulligan {
+			t.Fatalf("Expected player %d to have a pending mulligan", i+1)
+		}
+		if len(player.PendingChoice.Options) != len(player.Hand) {
+			t.Errorf("Expected mulligan options to list the opening hand, got %d options for %d cards",
+				len(player.PendingChoice.Options), len(player.Hand))
+		}
+	}
+
+	// Actions are not allowed before the mulligan is over
+	if err := e.EndPlayerTurn(); err == nil {
+		t.Error("Expected an error when ending turn during mulligan, but got nil")
+	}
+
+	// First player replaces two cards
+	player1 := g.Players[0]
+	replaced := player1.Hand[0]
+	kept := player1.Hand[1]
+	if err := e.SubmitMulligan(player1, []int{0, 2}); err != nil {
+		t.Fatalf("SubmitMulligan returned an error: %v", err)
+	}
+
+	// Still waiting for the second player
+	if g.Phase != game.BeginMulligan {
+		t.Errorf("Expected phase to remain BeginMulligan, got %v", g.Phase)
+	}
+	if len(player1.Hand) != 3 {
+		t.Errorf("Expected player 1 hand size to stay 3, got %d", len(player1.Hand))
+	}
+	if len(player1.Deck) != 7 {
+		t.Errorf("Expected player 1 deck size to stay 7, got %d", len(player1.Deck))
+	}
+	if replaced.CurrentZone != game.ZONE_DECK {
+		t.Errorf("Expected replaced card to be back in deck, got %s", replaced.CurrentZone)
+	}
+	if player1.Hand[0] != kept {
+		t.Errorf("Expected kept card to stay in hand")
+	}
+
+	// Submitting twice is not allowed
+	if err := e.SubmitMulligan(player1, nil); err == nil {
+		t.Error("Expected an error when submitting mulligan twice, but got nil")
+	}
+
+	// Second player keeps their hand, the game continues to the first turn
+	if err := e.SubmitMulligan(g.Players[1], nil); err != nil {
+		t.Fatalf("SubmitMulligan returned an error: %v", err)
+	}
+	if g.Phase != game.MainAction {
+		t.Errorf("Expected phase to be MainAction after mulligan, got %v", g.Phase)
+	}
+	if g.CurrentTurn != 1 {
+		t.Errorf("Expected turn to be 1 after mulligan, got %d", g.CurrentTurn)
+	}
+}
+
+// TestSeededGameIsDeterministic tests that two games with the same seed deal the same opening hands
+func TestSeededGameIsDeterministic(t *testing.T) {
+	openingHands := func(seed int64) [][]int {
+		g := game.NewGameWithSeed(seed)
+		g.SkipMulligan = true
+		for i := 0; i < 2; i++ {
+			player := game.NewPlayer()
+			player.Hero = game.CreateTestHeroEntity(g, player)
+			for j := 0; j < 30; j++ {
+				entity := game.CreateTestMinionEntity(g, player, game.WithCost(j))
+				entity.CurrentZone = game.ZONE_DECK
+				player.Deck = append(player.Deck, entity)
+			}
+			g.Players = append(g.Players, player)
+		}
+
+		e := NewEngine(g)
+		if err := e.StartGame(); err != nil {
+			t.Fatalf("Failed to start game: %v", err)
+		}
+
+		// Every deck card has a distinct cost, use it to identify the card
+		hands := make([][]int, len(g.Players))
+		for i, player := range g.Players {
+			for _, entity := range player.Hand {
+				hands[i] = append(hands[i], entity.Card.Cost)
+			}
+		}
+		return hands
+	}
+
+	first := openingHands(2025)
+	second := openingHands(2025)
+	for i := range first {
+		if len(first[i]) != len(second[i]) {
+			t.Fatalf("Expected the same hand size for player %d, got %d and %d", i+1, len(first[i]), len(second[i]))
+		}
+		for j := range first[i] {
+			if first[i][j] != second[i][j] {
+				t.Errorf("Expected the same opening hand for player %d, card %d differs: %d vs %d",
+					i+1, j+1, first[i][j], second[i][j])
+			}
+		}
+	}
+}
+
+// TestGameOverFromFatigue tests that the engine ends the game when a hero dies during a phase
+func TestGameOverFromFatigue(t *testing.T) {
+	g := game.CreateTestGame()
+	e := NewEngine(g)
+
+	if err := e.StartGame(); err != nil {
+		t.Fatalf("Failed to start game: %v", err)
+	}
+
+	// Second player dies to fatigue on their draw
+	player2 := g.Players[1]
+	player2.Deck = nil
+	player2.Hero.Health = 1
+
+	if err := e.EndPlayerTurn(); err != nil {
+		t.Fatalf("EndPlayerTurn returned an error: %v", err)
+	}
+
+	if g.Phase != game.FinalGameover {
+		t.Fatalf("Expected phase to be FinalGameover, got %v", g.Phase)
+	}
+	if g.Winner() != g.Players[0] {
+		t.Errorf("Expected player 1 to be the winner")
+	}
+
+	// No more actions once the game is over
+	if err := e.PlayCard(g.Players[0], 0, nil, -1, 0); err == nil {
+		t.Error("Expected an error when playing a card after the game is over, but got nil")
+	}
+	if err := e.EndPlayerTurn(); err == nil {
+		t.Error("Expected an error when ending turn after the game is over, but got nil")
+	}
+}
+
+// TestGameOverDraw tests that the game is a draw when both heroes die at once
+func TestGameOverDraw(t *testing.T) {
+	g := game.CreateTestGame()
+	e := NewEngine(g)
+
+	if err := e.StartGame(); err != nil {
+		t.Fatalf("Failed to start game: %v", err)
+	}
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Hero.Health = 2
+	player2.Hero.Health = 2
+
+	attacker := game.CreateTestMinionEntity(g, player1, game.WithAttack(2), game.WithHealth(2))
+	g.AddEntityToField(player1, attacker, -1)
+	attacker.Exhausted = false
+
+	// Player 1's hero is dealt lethal damage, both heroes are checked in the next death pass
+	g.DealDamage(nil, player1.Hero, 2)
+	if err := e.Attack(attacker, player2.Hero, false); err != nil {
+		t.Fatalf("Attack returned an error: %v", err)
+	}
+
+	if g.Phase != game.FinalGameover {
+		t.Fatalf("Expected phase to be FinalGameover, got %v", g.Phase)
+	}
+	for i, player := range g.Players {
+		if player.PlayState != game.PlayStateTied {
+			t.Errorf("Expected player %d to be tied, got %s", i+1, player.PlayState)
+		}
+	}
+}
+
+// TestConcede tests that conceding ends the game
+func TestConcede(t *testing.T) {
+	g := game.CreateTestGame()
+	e := NewEngine(g)
+
+	if err := e.StartGame(); err != nil {
+		t.Fatalf("Failed to start game: %v", err)
+	}
+
+	if err := e.Concede(g.Players[0]); err != nil {
+		t.Fatalf("Concede returned an error: %v", err)
+	}
+
+	if g.Phase != game.FinalGameover {
+		t.Errorf("Expected phase to be FinalGameover, got %v", g.Phase)
+	}
+	if g.Players[0].PlayState != game.PlayStateConceded {
+		t.Errorf("Expected player 1 to have conceded, got %s", g.Players[0].PlayState)
+	}
+	if g.Winner() != g.Players[1] {
+		t.Errorf("Expected player 2 to be the winner")
+	}
+
+	if err := e.Concede(g.Players[1]); err == nil {
+		t.Error("Expected an error when conceding after the game is over, but got nil")
+	}
+}
+
+// TestPerformPlayerAction tests that actions are validated and applied through the single entry point
+func TestPerformPlayerAction(t *testing.T) {
+	g := game.CreateTestGame()
+	e := NewEngine(g)
+
+	if err := e.StartGame(); err != nil {
+		t.Fatalf("Failed to start game: %v", err)
+	}
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Mana = 10
+
+	// Actions from the player whose turn it is not are rejected
+	err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player2})
+	if err == nil {
+		t.Error("Expected an error when acting on the opponent's turn, but got nil")
+	}
+
+	// Play a card
+	handSize := len(player1.Hand)
+	err = e.PerformPlayerAction(game.Action{
+		Type:      game.ActionPlayCard,
+		Player:    player1,
+		HandIndex: 0,
+		Position:  -1,
+	})
+	if err != nil {
+		t.Fatalf("Failed to play card: %v", err)
+	}
+	if len(player1.Hand) != handSize-1 || len(player1.Field) != 1 {
+		t.Errorf("Expected the card to move from hand to field")
+	}
+
+	// Attack with a character that is not yours is rejected
+	enemy := game.CreateTestMinionEntity(g, player2)
+	g.AddEntityToField(player2, enemy, -1)
+	err = e.PerformPlayerAction(game.Action{
+		Type:   game.ActionAttack,
+		Player: player1,
+		Source: enemy,
+		Target: player1.Hero,
+	})
+	if err == nil {
+		t.Error("Expected an error when attacking with an enemy character, but got nil")
+	}
+
+	// Attack with a ready minion
+	attacker := player1.Field[0]
+	attacker.Exhausted = false
+	err = e.PerformPlayerAction(game.Action{
+		Type:   game.ActionAttack,
+		Player: player1,
+		Source: attacker,
+		Target: player2.Hero,
+	})
+	if err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+	if player2.Hero.Health != 30-attacker.Attack {
+		t.Errorf("Expected enemy hero health to be %d, got %d", 30-attacker.Attack, player2.Hero.Health)
+	}
+
+	// End turn
+	if err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player1}); err != nil {
+		t.Fatalf("Failed to end turn: %v", err)
+	}
+	if g.CurrentPlayer != player2 {
+		t.Errorf("Expected current player to be player 2 after end turn")
+	}
+
+	// Concede is allowed for either player
+	if err := e.PerformPlayerAction(game.Action{Type: game.ActionConcede, Player: player1}); err != nil {
+		t.Fatalf("Failed to concede: %v", err)
+	}
+	if g.Winner() != player2 {
+		t.Errorf("Expected player 2 to be the winner after player 1 conceded")
+	}
+}
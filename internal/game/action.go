@@ -0,0 +1,186 @@
+package game
+
+// ActionType represents the different kinds of actions a player can take
+type ActionType int
+
+const (
+	ActionPlayCard ActionType = iota
+	ActionAttack
+	ActionHeroPower
+	ActionEndTurn
+	ActionConcede
+	ActionChoose
+)
+
+// String returns a string representation of the ActionType
+func (a ActionType) String() string {
+	switch a {
+	case ActionPlayCard:
+		return "PlayCard"
+	case ActionAttack:
+		return "Attack"
+	case ActionHeroPower:
+		return "HeroPower"
+	case ActionEndTurn:
+		return "EndTurn"
+	case ActionConcede:
+		return "Concede"
+	case ActionChoose:
+		return "Choose"
+	default:
+		return "Unknown"
+	}
+}
+
+// Action represents a single decision made by a player
+// Fields that do not apply to the action type are ignored
+type Action struct {
+	Type      ActionType
+	Player    *Player // Player taking the action
+	HandIndex int     // PlayCard: index of the card in hand
+	Source    *Entity // Attack: the attacking character; HeroPower: the hero power
+	Target    *Entity // PlayCard, HeroPower: optional target; Attack: the defender
+	Position  int     // PlayCard: field position for minions (-1 for auto-positioning)
+	ChooseOne int     // PlayCard: index for choose one effects
+	Choice    int     // Choose: index of the chosen option of the pending choice
+}
+
+// LegalActions lists every action the player can currently take
+// Conceding is always possible and is not listed
+func (g *Game) LegalActions(player *Player) []Action {
+	actions := make([]Action, 0)
+
+	if g.Phase != MainAction || g.IsGameOver() {
+		return actions
+	}
+
+	// A pending Discover has to be answered before anything else
+	if g.HasPendingChoice() {
+		if player.PendingChoice != nil && player.PendingChoice.Type == ChoiceDiscover {
+			for i := range player.PendingChoice.Options {
+				actions = append(actions, Action{Type: ActionChoose, Player: player, Choice: i})
+			}
+		}
+		return actions
+	}
+
+	if player != g.CurrentPlayer {
+		return actions
+	}
+
+	// Play cards from hand
+	for handIndex, entity := range player.Hand {
+		actions = append(actions, g.legalPlayCardActions(player, handIndex, entity)...)
+	}
+
+	// Attack with the hero and minions
+	attackers := append([]*Entity{player.Hero}, player.Field...)
+	for _, attacker := range attackers {
+		for _, defender := range g.attackTargets(player) {
+			if g.CanAttack(attacker, defender) != nil {
+				continue
+			}
+			actions = append(actions, Action{
+				Type:   ActionAttack,
+				Player: player,
+				Source: attacker,
+				Target: defender,
+			})
+		}
+	}
+
+	// Use the hero power
+	if player.HeroPower != nil {
+		for _, target := range g.playTargets(player, player.HeroPower, player.HeroPower.Card.Requirements) {
+			if g.TestUseHeroPower(player, target) != nil {
+				continue
+			}
+			actions = append(actions, Action{
+				Type:   ActionHeroPower,
+				Player: player,
+				Source: player.HeroPower,
+				Target: target,
+			})
+		}
+	}
+
+	// End turn
+	actions = append(actions, Action{Type: ActionEndTurn, Player: player})
+
+	return actions
+}
+
+// legalPlayCardActions lists every valid way to play a card from hand
+func (g *Game) legalPlayCardActions(player *Player, handIndex int, entity *Entity) []Action {
+	actions := make([]Action, 0)
+
+	// Minions need a free slot on the field
+	positions := []int{-1}
+	if entity.Card.Type == Minion {
+		if len(player.Field) >= player.FieldSize {
+			return actions
+		}
+		positions = make([]int, 0, len(player.Field)+1)
+		for pos := 0; pos <= len(player.Field); pos++ {
+			positions = append(positions, pos)
+		}
+	}
+
+	// Each option of a Choose One card is a separate way to play it
+	choices := []int{0}
+	if entity.Card.HasChooseOne() && !g.ChooseBoth(player) {
+		choices = make([]int, len(entity.Card.ChooseOne))
+		for i := range choices {
+			choices[i] = i
+		}
+	}
+
+	for _, choice := range choices {
+		for _, target := range g.playTargets(player, entity, g.PlayRequirements(player, entity, choice)) {
+			if g.TestPlayCard(player, entity, target, choice) != nil {
+				continue
+			}
+			for _, pos := range positions {
+				actions = append(actions, Action{
+					Type:      ActionPlayCard,
+					Player:    player,
+					HandIndex: handIndex,
+					Target:    target,
+					Position:  pos,
+					ChooseOne: choice,
+				})
+			}
+		}
+	}
+
+	return actions
+}
+
+// playTargets returns the candidate targets for playing a card with the requirements reqs
+// nil stands for playing the card without a target
+func (g *Game) playTargets(player *Player, entity *Entity, reqs map[PlayRequirement]int) []*Entity {
+	if !takesTarget(reqs) {
+		return []*Entity{nil}
+	}
+
+	targets := g.validPlayTargets(player, entity, reqs)
+	if _, toPlay := reqs[REQ_TARGET_TO_PLAY]; len(targets) == 0 && !toPlay {
+		return []*Entity{nil}
+	}
+	return targets
+}
+
+// attackTargets returns every enemy character of the player
+func (g *Game) attackTargets(player *Player) []*Entity {
+	targets := make([]*Entity, 0)
+	for _, opponent := range g.Players {
+		if opponent == player {
+			continue
+		}
+		if opponent.Hero != nil {
+			targets = append(targets, opponent.Hero)
+		}
+		targets = append(targets, opponent.Field...)
+	}
+	return targets
+}
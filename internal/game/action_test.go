@@ -0,0 +1,91 @@
+package game
+
+import (
+	"testing"
+)
+
+// countActions counts the actions of a specific type
+func countActions(actions []Action, actionType ActionType) int {
+	count := 0
+	for _, action := range actions {
+		if action.Type == actionType {
+			count++
+		}
+	}
+	return count
+}
+
+// TestLegalActions tests the Game.LegalActions function
+func TestLegalActions(t *testing.T) {
+	g := CreateTestGame()
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+
+	// Setup hand: a minion, a spell and a minion that is too expensive
+	g.AddEntityToHand(player1, CreateTestMinionEntity(g, player1), -1)
+	g.AddEntityToHand(player1, CreateTestSpellEntity(g, player1), -1)
+	g.AddEntityToHand(player1, CreateTestMinionEntity(g, player1, WithCost(10)), -1)
+	player1.Mana = 5
+
+	// Setup fields: a ready minion and an exhausted one against an enemy minion
+	attacker := CreateTestMinionEntity(g, player1, WithName("Attacker"))
+	g.AddEntityToField(player1, attacker, -1)
+	attacker.Exhausted = false
+	g.AddEntityToField(player1, CreateTestMinionEntity(g, player1), -1)
+	defender := CreateTestMinionEntity(g, player2)
+	g.AddEntityToField(player2, defender, -1)
+
+	actions := g.LegalActions(player1)
+
+	// The minion can be placed in any of the 3 slots, the spell once
+	if count := countActions(actions, ActionPlayCard); count != 4 {
+		t.Errorf("Expected 4 play card actions, got %d", count)
+	}
+	for _, action := range actions {
+		if action.Type == ActionPlayCard && action.HandIndex == 2 {
+			t.Errorf("Expected the expensive minion not to be playable")
+		}
+	}
+
+	// Only the ready minion can attack, the hero has no attack
+	if count := countActions(actions, ActionAttack); count != 2 {
+		t.Fatalf("Expected 2 attack actions, got %d", count)
+	}
+	for _, action := range actions {
+		if action.Type != ActionAttack {
+			continue
+		}
+		if action.Source != attacker {
+			t.Errorf("Expected only the ready minion to attack, got %s", action.Source.Card.Name)
+		}
+		if action.Target != defender && action.Target != player2.Hero {
+			t.Errorf("Expected attack targets to be the enemy minion or hero")
+		}
+	}
+
+	if count := countActions(actions, ActionEndTurn); count != 1 {
+		t.Errorf("Expected 1 end turn action, got %d", count)
+	}
+
+	// The opponent has no legal actions on this turn
+	if actions := g.LegalActions(player2); len(actions) != 0 {
+		t.Errorf("Expected no legal actions for the opponent, got %d", len(actions))
+	}
+}
+
+// TestLegalActionsFullField tests that minions cannot be played on a full field
+func TestLegalActionsFullField(t *testing.T) {
+	g := CreateTestGame()
+	player := g.Players[0]
+	player.Mana = 10
+
+	for i := 0; i < player.FieldSize; i++ {
+		g.AddEntityToField(player, CreateTestMinionEntity(g, player), -1)
+	}
+	g.AddEntityToHand(player, CreateTestMinionEntity(g, player), -1)
+
+	actions := g.LegalActions(player)
+	if count := countActions(actions, ActionPlayCard); count != 0 {
+		t.Errorf("Expected no play card actions on a full field, got %d", count)
+	}
+}
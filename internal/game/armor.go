@@ -0,0 +1,39 @@
+package game
+
+import "github.com/openhs/internal/logger"
+
+// GainArmor gives armor to a hero
+func (g *Game) GainArmor(hero *Entity, amount int) {
+	if hero == nil || amount <= 0 {
+		return
+	}
+
+	hero.Armor += amount
+	logger.Debug("Armor gained", logger.String("hero", hero.Card.Name), logger.Int("amount", amount))
+
+	// Create context for armor trigger
+	armorCtx := TriggerContext{
+		Game:         g,
+		TargetEntity: hero,
+		Value:        amount,
+		Phase:        g.Phase,
+	}
+	g.TriggerManager.ActivateTrigger(TriggerArmorGained, armorCtx)
+}
+
+// absorbWithArmor removes as much of the damage as possible from the target's armor
+// Returns the damage left for health
+func absorbWithArmor(target *Entity, amount int) int {
+	if target.Armor <= 0 {
+		return amount
+	}
+
+	absorbed := amount
+	if absorbed > target.Armor {
+		absorbed = target.Armor
+	}
+	target.Armor -= absorbed
+
+	logger.Debug("Armor absorbed damage", logger.String("target", target.Card.Name), logger.Int("amount", absorbed))
+	return amount - absorbed
+}
@@ -2,6 +2,7 @@ package game
 
 import (
 	"errors"
+	"sort"
 
 	"github.com/openhs/internal/logger"
 )
@@ -23,11 +24,21 @@ func (g *Game) Attack(attacker *Entity, defender *Entity, skipValidation bool) e
 		logger.String("attacker", attacker.Card.Name),
 		logger.String("defender", defender.Card.Name))
 
-	// TODO: Process pre-attack triggers
+	// Process pre-attack triggers
+	beforeAttackCtx := TriggerContext{
+		Game:         g,
+		SourceEntity: attacker,
+		TargetEntity: defender,
+		Phase:        g.Phase,
+	}
+	g.TriggerManager.ActivateTrigger(TriggerBeforeAttack, beforeAttackCtx)
 
-	// Get attack values
-	attackerDamage := attacker.Attack
-	defenderDamage := defender.Attack
+	// Get attack values, heroes do not strike back when attacked
+	attackerDamage := g.EffectiveAttack(attacker)
+	defenderDamage := 0
+	if defender.Card.Type == Minion {
+		defenderDamage = g.EffectiveAttack(defender)
+	}
 
 	// Decrease weapon durability if attacker is a hero
 	var attackerWeapon *Entity
@@ -38,14 +49,14 @@ func (g *Game) Attack(attacker *Entity, defender *Entity, skipValidation bool) e
 
 	// Deal damage simultaneously
 	if attackerDamage > 0 {
-		g.DealDamage(attacker, defender, attackerDamage)
+		dealt := g.DealDamage(attacker, defender, attackerDamage)
 
-		// Check for poisonous effect on attacker
-		if defender.Card.Type == Minion {
+		// Check for poisonous effect on attacker, a divine shield blocks it
+		if dealt > 0 && defender.Card.Type == Minion {
 			if (attackerWeapon != nil && HasTag(attackerWeapon.Tags, TAG_POISONOUS)) ||
 				HasTag(attacker.Tags, TAG_POISONOUS) {
 				// If defender is still alive after taking damage, mark it for destruction
-				defender.IsDestroyed = true
+				g.Destroy(defender)
 				logger.Info("Poisonous effect triggered",
 					logger.String("source", attacker.Card.Name),
 					logger.String("target", defender.Card.Name))
@@ -53,12 +64,12 @@ func (g *Game) Attack(attacker *Entity, defender *Entity, skipValidation bool) e
 		}
 	}
 	if defenderDamage > 0 {
-		g.DealDamage(defender, attacker, defenderDamage)
+		dealt := g.DealDamage(defender, attacker, defenderDamage)
 
-		// Check for poisonous effect on defender
-		if attacker.Card.Type == Minion && HasTag(defender.Tags, TAG_POISONOUS) {
+		// Check for poisonous effect on defender, a divine shield blocks it
+		if dealt > 0 && attacker.Card.Type == Minion && HasTag(defender.Tags, TAG_POISONOUS) {
 			// If attacker is still alive after taking damage, mark it for destruction
-			attacker.IsDestroyed = true
+			g.Destroy(attacker)
 			logger.Info("Poisonous effect triggered",
 				logger.String("source", defender.Card.Name),
 				logger.String("target", attacker.Card.Name))
@@ -68,6 +79,9 @@ func (g *Game) Attack(attacker *Entity, defender *Entity, skipValidation bool) e
 	// Mark attacker as having attacked this turn
 	attacker.NumAttackThisTurn++
 
+	// Attacking reveals a stealthed attacker
+	g.removeStealth(attacker)
+
 	// Check if attacker is exhausted
 	expectedAttacks := 1
 	if HasTag(attacker.Tags, TAG_WINDFURY) ||
@@ -80,10 +94,17 @@ func (g *Game) Attack(attacker *Entity, defender *Entity, skipValidation bool) e
 
 	// Process special effects like poison, freeze, etc.
 
-	// Process post-attack triggers or effects if needed
+	// Process post-attack triggers
+	afterAttackCtx := TriggerContext{
+		Game:         g,
+		SourceEntity: attacker,
+		TargetEntity: defender,
+		Phase:        g.Phase,
+	}
+	g.TriggerManager.ActivateTrigger(TriggerAfterAttack, afterAttackCtx)
 
 	// Check for deaths and update aura
-	g.processDestroyAndUpdateAura()
+	g.ProcessDestroyAndUpdateAura()
 
 	// Set the phase back to main
 	g.Phase = MainAction
@@ -99,7 +120,7 @@ func (g *Game) validateAttack(attacker *Entity, defender *Entity) error {
 	}
 
 	// Check if attacker can attack
-	if attacker.Attack <= 0 {
+	if g.EffectiveAttack(attacker) <= 0 {
 		return errors.New("attacker has 0 or negative attack")
 	}
 
@@ -113,6 +134,16 @@ func (g *Game) validateAttack(attacker *Entity, defender *Entity) error {
 		return errors.New("frozen entities cannot attack")
 	}
 
+	// Check if attacker is not allowed to attack at all
+	if HasTag(attacker.Tags, TAG_CANT_ATTACK) {
+		return errors.New("entity cannot attack")
+	}
+
+	// Check if attacker is dormant
+	if IsDormant(attacker) {
+		return errors.New("dormant entities cannot attack")
+	}
+
 	// Check if defender is a valid target
 	// Only minion or hero of another player can be attack target
 	if defender.Card.Type != Minion && defender.Card.Type != Hero {
@@ -124,6 +155,11 @@ func (g *Game) validateAttack(attacker *Entity, defender *Entity) error {
 		return errors.New("cannot attack your own entities")
 	}
 
+	// Check stealth and taunt restrictions
+	if err := g.CanBeAttacked(defender); err != nil {
+		return err
+	}
+
 	// Check rush restriction - Entities with rush cannot attack heroes on their first turn in field
 	if HasTag(attacker.Tags, TAG_RUSH) &&
 		defender.Card.Type == Hero &&
@@ -131,103 +167,160 @@ func (g *Game) validateAttack(attacker *Entity, defender *Entity) error {
 		return errors.New("minions with rush cannot attack heroes on their first turn")
 	}
 
-	// Additional validation logic can be added here
-	// - Check for special effects like taunt, stealth, etc.
-
 	return nil
 }
 
-func (g *Game) processDestroyAndUpdateAura() {
+// EffectiveAttack returns the attack a character fights with
+// This is its own attack including buffs, heroes add the attack of their weapon
+func (g *Game) EffectiveAttack(entity *Entity) int {
+	if entity == nil {
+		return 0
+	}
+
+	attack := entity.Attack
+	if entity.Card.Type == Hero && entity.Owner != nil && entity.Owner.Weapon != nil {
+		attack += entity.Owner.Weapon.Attack
+	}
+
+	if attack < 0 {
+		return 0
+	}
+	return attack
+}
+
+// ProcessDestroyAndUpdateAura resolves deaths and refreshes auras
+// It runs after each action and at the start of a turn
+func (g *Game) ProcessDestroyAndUpdateAura() {
 	// Update aura
+	g.UpdateAuras()
 
 	// Trigger summon events
 
 	// Process destroy, trigger deathrattle and reborn events (loop until no more entity dies)
-	for g.processDestroyedWeapons() || g.ProcessGraveyard() {
+	for g.ProcessGraveyard() {
 		g.processReborn()
 	}
 
 	// Update aura
+	g.UpdateAuras()
+
+	// Check if any hero has died
+	g.CheckHeroDeaths()
 }
 
-func (g *Game) processDestroyedWeapons() bool {
-	destroyed := false
+// ProcessGraveyard moves destroyed minions and weapons to the graveyard and resolves their deathrattles
+// Entities that die together resolve in order of play
+// Returns true if any entity was destroyed
+func (g *Game) ProcessGraveyard() bool {
+	dead := make([]*Entity, 0)
+
 	for _, player := range g.Players {
 		if player.Weapon != nil && (player.Weapon.Health <= 0 || player.Weapon.IsDestroyed) {
-			player.Graveyard = append(player.Graveyard, player.Weapon)
-			player.Weapon.CurrentZone = ZONE_GRAVEYARD
+			dead = append(dead, player.Weapon)
 			player.Weapon = nil
-			destroyed = true
 		}
-	}
-	return destroyed
-}
 
-func (g *Game) ProcessGraveyard() bool {
-	destroyed := false
-	for _, player := range g.Players {
+		// Remove dying minions, remembering the slot each one leaves behind
+		remaining := make([]*Entity, 0, len(player.Field))
 		for _, minion := range player.Field {
 			if minion.Health <= 0 || minion.IsDestroyed {
-				destroyed = true
-				g.removeEntityFromBoard(player, minion)
+				minion.LastFieldPosition = len(remaining)
+				dead = append(dead, minion)
+			} else {
+				remaining = append(remaining, minion)
+			}
+		}
+		player.Field = remaining
+	}
 
-				// Create context for minion death trigger
-				deathCtx := TriggerContext{
-					Game:         g,
-					SourceEntity: minion,
-					Phase:        g.Phase,
-				}
+	if len(dead) == 0 {
+		return false
+	}
 
-				// Trigger minion death event
-				g.TriggerManager.ActivateTrigger(TriggerMinionDeath, deathCtx)
+	sort.SliceStable(dead, func(i, j int) bool {
+		return dead[i].PlayOrder < dead[j].PlayOrder
+	})
 
-				// TODO: trigger death, deathrattle, infuse, add to reborn list, etc.
+	// Add to graveyard
+	for _, entity := range dead {
+		entity.Owner.Graveyard = append(entity.Owner.Graveyard, entity)
+		entity.CurrentZone = ZONE_GRAVEYARD
+	}
 
-				// add to graveyard
-				player.Graveyard = append(player.Graveyard, minion)
-				minion.CurrentZone = ZONE_GRAVEYARD
+	for _, entity := range dead {
+		if entity.Card.Type == Minion {
+			// Create context for minion death trigger
+			deathCtx := TriggerContext{
+				Game:         g,
+				SourceEntity: entity,
+				Phase:        g.Phase,
 			}
+
+			// Trigger minion death event
+			g.TriggerManager.ActivateTrigger(TriggerMinionDeath, deathCtx)
+		}
+
+		// TODO: trigger infuse, etc.
+		g.processDeathrattle(entity)
+
+		if entity.Card.Type == Minion && HasTag(entity.Tags, TAG_REBORN) {
+			g.pendingReborn = append(g.pendingReborn, entity)
 		}
 	}
 
-	return destroyed
+	return true
 }
 
-func (g *Game) processReborn() {
-	// Reborn implementation
-}
+// processDeathrattle runs the deathrattle powers of an entity that has left play
+// Summons can use entity.LastFieldPosition to appear where the minion died
+func (g *Game) processDeathrattle(entity *Entity) {
+	// Silenced entities lost their card text
+	if IsSilenced(entity) {
+		return
+	}
 
-// ProcessAttack handles an attack from one entity to another
-func (g *Game) ProcessAttack(attacker, defender *Entity) error {
-	// Create context for attack triggers
-	beforeAttackCtx := TriggerContext{
-		Game:         g,
-		SourceEntity: attacker,
-		TargetEntity: defender,
-		Phase:        g.Phase,
+	for _, power := range entity.Card.Powers {
+		if power.Type == PowerTypeDeathrattle {
+			logger.Debug("Deathrattle triggered", logger.String("name", entity.Card.Name))
+			power.Action(g, entity, nil)
+		}
 	}
+}
 
-	// Activate before attack triggers
-	g.TriggerManager.ActivateTrigger(TriggerBeforeAttack, beforeAttackCtx)
+// processReborn returns minions with reborn that died in the last death pass
+// Each comes back as a fresh copy with 1 health and no reborn, in the slot where it died
+func (g *Game) processReborn() {
+	pending := g.pendingReborn
+	g.pendingReborn = nil
+
+	for _, dead := range pending {
+		player := dead.Owner
+		if len(player.Field) >= player.FieldSize {
+			logger.Debug("Reborn failed, field is full", logger.String("name", dead.Card.Name))
+			continue
+		}
 
-	// Use the existing Attack method with skipValidation=false
-	err := g.Attack(attacker, defender, false)
-	if err != nil {
-		return err
-	}
+		reborn := NewEntity(dead.Card, g, player)
+		RemoveTag(&reborn.Tags, TAG_REBORN)
 
-	// Create context for after attack triggers
-	afterAttackCtx := TriggerContext{
-		Game:         g,
-		SourceEntity: attacker,
-		TargetEntity: defender,
-		Phase:        g.Phase,
-	}
+		// The 1 health is the copy's base, so silence doesn't restore the card's health
+		reborn.BaseHealth = 1
+		g.RecomputeStats(reborn)
 
-	// Activate after attack triggers
-	g.TriggerManager.ActivateTrigger(TriggerAfterAttack, afterAttackCtx)
+		pos := dead.LastFieldPosition
+		if pos > len(player.Field) {
+			pos = len(player.Field)
+		}
 
-	return nil
+		logger.Debug("Reborn triggered", logger.String("name", dead.Card.Name))
+		g.AddEntityToField(player, reborn, pos)
+	}
+}
+
+// ProcessAttack handles an attack from one entity to another
+// Before and after attack triggers are activated by Attack
+func (g *Game) ProcessAttack(attacker, defender *Entity) error {
+	return g.Attack(attacker, defender, false)
 }
 
 // CanAttack checks if an entity can attack a target
@@ -252,31 +345,68 @@ func (g *Game) CanBeAttacked(defender *Entity) error {
 	if HasTag(defender.Tags, TAG_STEALTH) {
 		return errors.New("stealthed entities cannot be attacked")
 	}
+	if IsDormant(defender) {
+		return errors.New("dormant entities cannot be attacked")
+	}
 
-	// Check for taunt restriction - if opponent has taunt, must attack that
-	if !HasTag(defender.Tags, TAG_TAUNT) {
-		opponentHasTaunt := false
-		for _, player := range g.Players {
-			if player == defender.Owner {
-				continue
-			}
-
-			for _, minion := range player.Field {
-				if HasTag(minion.Tags, TAG_TAUNT) {
-					opponentHasTaunt = true
-					break
-				}
+	// Check for taunt restriction - if the defender's side has taunt, must attack that
+	// Stealthed and dormant taunts cannot be attacked, so they do not count
+	if !HasTag(defender.Tags, TAG_TAUNT) && defender.Owner != nil {
+		for _, minion := range defender.Owner.Field {
+			if HasTag(minion.Tags, TAG_TAUNT) && !HasTag(minion.Tags, TAG_STEALTH) && !IsDormant(minion) {
+				return errors.New("must attack entities with taunt first")
 			}
 		}
+	}
 
-		if opponentHasTaunt {
-			return errors.New("must attack entities with taunt first")
-		}
+	return nil
+}
+
+// CanBeTargeted checks if a target can be chosen by a card or effect controlled by the player
+func (g *Game) CanBeTargeted(player *Player, target *Entity) error {
+	if target == nil {
+		return errors.New("invalid target")
+	}
+
+	// Stealthed characters cannot be targeted by the opponent
+	if HasTag(target.Tags, TAG_STEALTH) && target.Owner != player {
+		return errors.New("stealthed entities cannot be targeted by the opponent")
+	}
+
+	// Dormant minions cannot be targeted by anyone
+	if IsDormant(target) {
+		return errors.New("dormant entities cannot be targeted")
 	}
 
 	return nil
 }
 
+// CanBeTargetedBy checks if a target can be chosen by the source entity
+// Characters that can't be targeted (Elusive) ignore spells and hero powers, but not battlecries or attacks
+func (g *Game) CanBeTargetedBy(source *Entity, target *Entity) error {
+	if source == nil {
+		return errors.New("invalid source")
+	}
+
+	if err := g.CanBeTargeted(source.Owner, target); err != nil {
+		return err
+	}
+
+	if HasTag(target.Tags, TAG_CANT_BE_TARGETED) &&
+		(source.Card.Type == Spell || source.Card.Type == HeroPower) {
+		return errors.New("entity cannot be targeted by spells or hero powers")
+	}
+
+	return nil
+}
+
+// removeStealth removes stealth from an entity that attacked or dealt damage
+func (g *Game) removeStealth(entity *Entity) {
+	if RemoveTag(&entity.Tags, TAG_STEALTH) {
+		logger.Debug("Entity lost stealth", logger.String("entity", entity.Card.Name))
+	}
+}
+
 // ShouldExhaustAfterAttack determines if an entity should be exhausted after attacking
 func (g *Game) ShouldExhaustAfterAttack(entity *Entity) bool {
 	if entity == nil {
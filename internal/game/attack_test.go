@@ -194,9 +194,6 @@ func TestBasicAttack(t *testing.T) {
 		// Add defender to opponent's field
 		player2.Field = append(player2.Field, defenderEntity)
 
-		// Set hero's attack value to match weapon's attack
-		player1.Hero.Attack = player1.Weapon.Attack
-
 		// Perform attack, skip validation
 		err := g.Attack(player1.Hero, defenderEntity, true)
 
@@ -280,7 +277,7 @@ func TestProcessDestroyAndUpdateAura(t *testing.T) {
 		player2.Field = append(player2.Field, deadMinion2)
 
 		// Process deaths
-		g.processDestroyAndUpdateAura()
+		g.ProcessDestroyAndUpdateAura()
 
 		// Assert minions are moved to graveyard
 		if len(player1.Field) != 0 {
@@ -314,7 +311,7 @@ func TestProcessDestroyAndUpdateAura(t *testing.T) {
 		player.Field = append(player.Field, minion1, minion2)
 
 		// Process deaths
-		g.processDestroyAndUpdateAura()
+		g.ProcessDestroyAndUpdateAura()
 
 		// Assert only marked minion is moved to graveyard
 		if len(player.Field) != 1 {
@@ -343,7 +340,7 @@ func TestProcessDestroyAndUpdateAura(t *testing.T) {
 		player.Weapon = CreateTestWeaponEntity(g, player, WithName("Broken Weapon"), WithHealth(0))
 
 		// Process deaths
-		g.processDestroyAndUpdateAura()
+		g.ProcessDestroyAndUpdateAura()
 
 		// Assert weapon is moved to graveyard
 		if player.Weapon != nil {
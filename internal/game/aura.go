@@ -0,0 +1,98 @@
+package game
+
+// Aura is an ongoing effect of an entity in play
+// Every entity matching Affects gets the aura's buff while the source stays in play
+type Aura struct {
+	Affects func(g *Game, source, target *Entity) bool
+	Buff    Buff
+}
+
+// AdjacentMinions affects the minions next to the source
+func AdjacentMinions(g *Game, source, target *Entity) bool {
+	if target.CurrentZone != ZONE_PLAY || target.Card.Type != Minion || target.Owner != source.Owner {
+		return false
+	}
+
+	field := source.Owner.Field
+	for i, minion := range field {
+		if minion == source {
+			return (i > 0 && field[i-1] == target) || (i+1 < len(field) && field[i+1] == target)
+		}
+	}
+	return false
+}
+
+// OtherFriendlyMinions affects the other minions the source's owner controls
+func OtherFriendlyMinions(g *Game, source, target *Entity) bool {
+	return target != source && target.Owner == source.Owner &&
+		target.CurrentZone == ZONE_PLAY && target.Card.Type == Minion
+}
+
+// FriendlySpellsInHand affects the spells in the hand of the source's owner
+func FriendlySpellsInHand(g *Game, source, target *Entity) bool {
+	return target.Owner == source.Owner && target.CurrentZone == ZONE_HAND && target.Card.Type == Spell
+}
+
+// UpdateAuras recomputes the buffs granted by auras of all entities in play
+// Auras of silenced or dormant entities are inactive
+func (g *Game) UpdateAuras() {
+	sources := make([]*Entity, 0)
+	targets := make([]*Entity, 0)
+	for _, player := range g.Players {
+		for _, entity := range buffedEntities(player) {
+			targets = append(targets, entity)
+			if entity.CurrentZone == ZONE_PLAY && len(entity.Card.Auras) > 0 &&
+				!IsSilenced(entity) && !IsDormant(entity) {
+				sources = append(sources, entity)
+			}
+		}
+	}
+
+	for _, target := range targets {
+		// Replace the aura buffs in one go so health is not lost while re-applying them
+		hadAura := false
+		oldTags := make([]Tag, 0)
+		buffs := make([]Buff, 0, len(target.Buffs))
+		for _, buff := range target.Buffs {
+			if buff.Aura {
+				hadAura = true
+				oldTags = append(oldTags, buff.Tags...)
+			} else {
+				buffs = append(buffs, buff)
+			}
+		}
+
+		hasAura := false
+		for _, source := range sources {
+			for _, aura := range source.Card.Auras {
+				if !aura.Affects(g, source, target) {
+					continue
+				}
+				buff := aura.Buff
+				buff.Source = source
+				buff.Player = source.Owner
+				buff.Aura = true
+				buffs = append(buffs, buff)
+				hasAura = true
+			}
+		}
+		if !hadAura && !hasAura {
+			continue
+		}
+		target.Buffs = buffs
+
+		for _, tag := range oldTags {
+			if !g.providesTag(target, tag.Type) {
+				RemoveTag(&target.Tags, tag.Type)
+			}
+		}
+		for _, buff := range target.Buffs {
+			if buff.Aura {
+				for _, tag := range buff.Tags {
+					SetTag(&target.Tags, tag.Type, tag.Value)
+				}
+			}
+		}
+		g.RecomputeStats(target)
+	}
+}
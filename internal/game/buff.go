@@ -1,5 +1,171 @@
 package game
 
-// Buff represents a temporary modification to an entity
+import "github.com/openhs/internal/logger"
+
+// BuffDuration describes how long a buff lasts
+type BuffDuration int
+
+const (
+	BuffPermanent     BuffDuration = iota // Lasts until silenced or removed
+	BuffThisTurn                          // Expires at the end of the current turn
+	BuffUntilNextTurn                     // Expires at the start of the next turn of the player who applied it
+)
+
+// Buff represents an enchantment on an entity
+// Stats of the entity are its base card plus the deltas of all its buffs
 type Buff struct {
+	Source   *Entity // Entity that applied the buff, may be nil
+	Attack   int
+	Health   int
+	Cost     int
+	Tags     []Tag // Tags granted while the buff is attached
+	Duration BuffDuration
+	Player   *Player // Player who applied the buff, used to expire BuffUntilNextTurn
+	Aura     bool    // Granted by an aura, refreshed on every aura update
+}
+
+// AddBuff attaches a buff to an entity and recomputes its stats
+// Granted tags are added to the entity right away
+func (g *Game) AddBuff(target *Entity, buff Buff) {
+	if target == nil {
+		return
+	}
+
+	if buff.Player == nil {
+		if buff.Source != nil && buff.Source.Owner != nil {
+			buff.Player = buff.Source.Owner
+		} else {
+			buff.Player = g.CurrentPlayer
+		}
+	}
+
+	target.Buffs = append(target.Buffs, buff)
+	for _, tag := range buff.Tags {
+		SetTag(&target.Tags, tag.Type, tag.Value)
+	}
+	g.RecomputeStats(target)
+
+	logger.Debug("Buff added", logger.String("name", target.Card.Name),
+		logger.Int("attack", buff.Attack), logger.Int("health", buff.Health), logger.Int("cost", buff.Cost))
+}
+
+// RemoveBuffs removes the buffs matching the filter from an entity and recomputes its stats
+// Returns the number of buffs removed
+func (g *Game) RemoveBuffs(target *Entity, filter func(buff Buff) bool) int {
+	if target == nil || len(target.Buffs) == 0 {
+		return 0
+	}
+
+	kept := make([]Buff, 0, len(target.Buffs))
+	removed := make([]Buff, 0)
+	for _, buff := range target.Buffs {
+		if filter(buff) {
+			removed = append(removed, buff)
+		} else {
+			kept = append(kept, buff)
+		}
+	}
+	if len(removed) == 0 {
+		return 0
+	}
+	target.Buffs = kept
+
+	// Remove granted tags the card or a remaining buff doesn't provide
+	for _, buff := range removed {
+		for _, tag := range buff.Tags {
+			if !g.providesTag(target, tag.Type) {
+				RemoveTag(&target.Tags, tag.Type)
+			}
+		}
+	}
+	g.RecomputeStats(target)
+
+	return len(removed)
+}
+
+// providesTag checks if the card text or a buff of an entity grants a tag
+func (g *Game) providesTag(entity *Entity, tagType TagType) bool {
+	if HasTag(entity.Card.Tags, tagType) && !IsSilenced(entity) {
+		return true
+	}
+	for _, buff := range entity.Buffs {
+		if HasTag(buff.Tags, tagType) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecomputeStats recomputes attack, max health and cost from the base card and the buffs
+// Gaining max health also gains health, losing max health only caps the current health
+func (g *Game) RecomputeStats(entity *Entity) {
+	attack := entity.Card.Attack
+	health := entity.Card.Health
+	if entity.BaseHealth > 0 {
+		health = entity.BaseHealth
+	}
+	cost := entity.Card.Cost
+	for _, buff := range entity.Buffs {
+		attack += buff.Attack
+		health += buff.Health
+		cost += buff.Cost
+	}
+
+	if attack < 0 {
+		attack = 0
+	}
+	if cost < 0 {
+		cost = 0
+	}
+
+	if health > entity.MaxHealth {
+		entity.Health += health - entity.MaxHealth
+	} else if entity.Health > health {
+		entity.Health = health
+	}
+
+	entity.Attack = attack
+	entity.MaxHealth = health
+	entity.Cost = cost
+}
+
+// ExpireTurnEffects removes the buffs and cost modifiers that only last for the current turn
+// This affects the entities of both players
+func (g *Game) ExpireTurnEffects() {
+	for _, player := range g.Players {
+		g.expireCostModifiers(player)
+		for _, entity := range buffedEntities(player) {
+			g.RemoveBuffs(entity, func(buff Buff) bool {
+				return buff.Duration == BuffThisTurn
+			})
+		}
+	}
+}
+
+// ExpireNextTurnEffects removes the buffs applied by a player that last until their next turn
+func (g *Game) ExpireNextTurnEffects(player *Player) {
+	for _, p := range g.Players {
+		for _, entity := range buffedEntities(p) {
+			g.RemoveBuffs(entity, func(buff Buff) bool {
+				return buff.Duration == BuffUntilNextTurn && buff.Player == player
+			})
+		}
+	}
+}
+
+// buffedEntities returns the entities of a player that can carry buffs
+func buffedEntities(player *Player) []*Entity {
+	entities := make([]*Entity, 0, 3+len(player.Field)+len(player.Hand))
+	if player.Hero != nil {
+		entities = append(entities, player.Hero)
+	}
+	if player.HeroPower != nil {
+		entities = append(entities, player.HeroPower)
+	}
+	if player.Weapon != nil {
+		entities = append(entities, player.Weapon)
+	}
+	entities = append(entities, player.Field...)
+	entities = append(entities, player.Hand...)
+	return entities
 }
@@ -3,18 +3,23 @@ package game
 // Card represents a card in the game
 // An empty card is a 0/0 minion with no cost and no effects
 type Card struct {
-	Name        string
-	ZhName      string
-	ID          string
-	Description string
-	Cost        int
-	Attack      int
-	Health      int
-	Type        CardType
-	Tags        []Tag                    // Card tags like Taunt, Divine Shield, etc.
-	Powers      []Power                  // Card powers
-	Load        func(g *Game, e *Entity) // Load functions register triggers to Game for Entity of this card
-	Unload      func(g *Game, e *Entity) // Unload functions remove triggers from Game when Entity is removed/silenced/...
+	Name         string
+	ZhName       string
+	ID           string
+	Description  string
+	Cost         int
+	Attack       int
+	Health       int
+	Armor        int // Armor gained when a hero card is played
+	Type         CardType
+	Tags         []Tag                    // Card tags like Taunt, Divine Shield, etc.
+	Powers       []Power                  // Card powers
+	Requirements map[PlayRequirement]int  // Play requirements and their parameters
+	Auras        []Aura                   // Ongoing effects while the entity is in play
+	ChooseOne    []ChooseOneOption        // Options of a Choose One card, the player picks one when playing it
+	HeroPower    string                   // Name of the hero power card, for hero cards
+	Load         func(g *Game, e *Entity) // Load functions register triggers to Game for Entity of this card
+	Unload       func(g *Game, e *Entity) // Unload functions remove triggers from Game when Entity is removed/silenced/...
 }
 
 // CardType represents the type of a card
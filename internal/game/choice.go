@@ -0,0 +1,30 @@
+package game
+
+// ChoiceType represents the kind of decision a player has to make
+type ChoiceType int
+
+const (
+	ChoiceMulligan ChoiceType = iota
+	ChoiceDiscover
+)
+
+// String returns a string representation of the ChoiceType
+func (c ChoiceType) String() string {
+	switch c {
+	case ChoiceMulligan:
+		return "Mulligan"
+	case ChoiceDiscover:
+		return "Discover"
+	default:
+		return "Unknown"
+	}
+}
+
+// PendingChoice represents a decision the engine is waiting for a player to make
+type PendingChoice struct {
+	Type     ChoiceType
+	Player   *Player
+	Options  []*Entity                     // Entities the player can choose from
+	Source   *Entity                       // Discover: the card that offered the choice
+	OnChoose func(g *Game, chosen *Entity) // Discover: continues the effect with the chosen entity
+}
@@ -0,0 +1,88 @@
+package game
+
+import "errors"
+
+// ChooseOneOption is one of the named options of a Choose One card
+// The option adds its powers and play requirements to those of the card
+type ChooseOneOption struct {
+	Name         string
+	ZhName       string
+	Powers       []Power
+	Requirements map[PlayRequirement]int
+}
+
+// HasChooseOne checks if a card lets the player choose between options
+func (c *Card) HasChooseOne() bool {
+	return len(c.ChooseOne) > 0
+}
+
+// ChooseBoth checks if the player's Choose One cards have both options combined
+// This is the case if the player's hero or a friendly minion that is not dormant has TAG_CHOOSE_BOTH
+func (g *Game) ChooseBoth(player *Player) bool {
+	if player.Hero != nil && HasTag(player.Hero.Tags, TAG_CHOOSE_BOTH) {
+		return true
+	}
+	for _, minion := range player.Field {
+		if HasTag(minion.Tags, TAG_CHOOSE_BOTH) && !IsDormant(minion) {
+			return true
+		}
+	}
+	return false
+}
+
+// chosenOptions returns the options of a Choose One card that run for the choice
+// Cards without options return nil, an invalid choice returns an error
+func (g *Game) chosenOptions(player *Player, entity *Entity, chooseOne int) ([]ChooseOneOption, error) {
+	options := entity.Card.ChooseOne
+	if len(options) == 0 {
+		return nil, nil
+	}
+	if g.ChooseBoth(player) {
+		return options, nil
+	}
+	if chooseOne < 0 || chooseOne >= len(options) {
+		return nil, errors.New("invalid choose one option")
+	}
+	return options[chooseOne : chooseOne+1], nil
+}
+
+// PlayRequirements returns the play requirements of a card for the chosen option
+// An invalid choice falls back to the requirements of the card itself
+func (g *Game) PlayRequirements(player *Player, entity *Entity, chooseOne int) map[PlayRequirement]int {
+	options, err := g.chosenOptions(player, entity, chooseOne)
+	if err != nil || len(options) == 0 {
+		return entity.Card.Requirements
+	}
+
+	reqs := make(map[PlayRequirement]int, len(entity.Card.Requirements))
+	for req, value := range entity.Card.Requirements {
+		reqs[req] = value
+	}
+	for _, option := range options {
+		for req, value := range option.Requirements {
+			reqs[req] = value
+		}
+	}
+	return reqs
+}
+
+// playPowers returns the powers that run when a card is played with the chosen option
+func (g *Game) playPowers(player *Player, entity *Entity, chooseOne int) []Power {
+	options, err := g.chosenOptions(player, entity, chooseOne)
+	if err != nil || len(options) == 0 {
+		return entity.Card.Powers
+	}
+
+	powers := append([]Power{}, entity.Card.Powers...)
+	for _, option := range options {
+		powers = append(powers, option.Powers...)
+	}
+	return powers
+}
+
+// takesTarget checks if a set of play requirements chooses a target
+func takesTarget(reqs map[PlayRequirement]int) bool {
+	_, toPlay := reqs[REQ_TARGET_TO_PLAY]
+	_, ifAvailable := reqs[REQ_TARGET_IF_AVAILABLE]
+	return toPlay || ifAvailable
+}
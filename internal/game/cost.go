@@ -0,0 +1,101 @@
+package game
+
+import (
+	"errors"
+
+	"github.com/openhs/internal/logger"
+)
+
+// CostModifier is a one-shot change to the cost of the next card a player plays that it applies to
+// For example "your next spell costs (2) less" is a modifier with Amount -2 that applies to spells
+type CostModifier struct {
+	Source   *Entity
+	Amount   int                       // Added to the cost, negative values reduce it
+	Applies  func(entity *Entity) bool // Cards the modifier applies to, nil applies to every card but hero powers
+	Duration BuffDuration              // BuffThisTurn modifiers are lost at the end of the turn if unused
+}
+
+// appliesTo checks if a cost modifier changes the cost of an entity
+func (m CostModifier) appliesTo(entity *Entity) bool {
+	if m.Applies == nil {
+		return entity.Card.Type != HeroPower
+	}
+	return m.Applies(entity)
+}
+
+// AddCostModifier gives a player a one-shot cost modifier
+func (g *Game) AddCostModifier(player *Player, modifier CostModifier) {
+	player.CostModifiers = append(player.CostModifiers, modifier)
+	logger.Debug("Cost modifier added", logger.Int("amount", modifier.Amount))
+}
+
+// EffectiveCost returns the cost to play a card or use a hero power
+// This is the cost of the entity with its buffs and auras plus the owner's one-shot modifiers, at least 0
+func (g *Game) EffectiveCost(entity *Entity) int {
+	cost := entity.Cost
+	if entity.Owner != nil {
+		for _, modifier := range entity.Owner.CostModifiers {
+			if modifier.appliesTo(entity) {
+				cost += modifier.Amount
+			}
+		}
+	}
+
+	if cost < 0 {
+		cost = 0
+	}
+	return cost
+}
+
+// CostsHealth checks if a card is paid with the health of its owner's hero instead of mana
+func CostsHealth(entity *Entity) bool {
+	return HasTag(entity.Tags, TAG_COSTS_HEALTH)
+}
+
+// canPayCost checks if the player can pay the effective cost of a card
+// Cards that cost health can always be paid, even if that kills the hero
+func (g *Game) canPayCost(player *Player, entity *Entity) error {
+	if !CostsHealth(entity) && g.EffectiveCost(entity) > player.Mana {
+		return errors.New("not enough mana")
+	}
+	return nil
+}
+
+// payCost spends the effective cost of a card and uses up the player's modifiers that applied to it
+func (g *Game) payCost(player *Player, entity *Entity) error {
+	if err := g.canPayCost(player, entity); err != nil {
+		return err
+	}
+
+	cost := g.EffectiveCost(entity)
+	if CostsHealth(entity) {
+		// Paying health is not damage, so armor and immune don't prevent it
+		if player.Hero != nil {
+			player.Hero.Health -= cost
+		}
+		logger.Debug("Health paid", logger.String("name", entity.Card.Name), logger.Int("cost", cost))
+	} else {
+		player.Mana -= cost
+	}
+
+	kept := make([]CostModifier, 0, len(player.CostModifiers))
+	for _, modifier := range player.CostModifiers {
+		if !modifier.appliesTo(entity) {
+			kept = append(kept, modifier)
+		}
+	}
+	player.CostModifiers = kept
+
+	return nil
+}
+
+// expireCostModifiers removes the unused cost modifiers of a player that only last for the current turn
+func (g *Game) expireCostModifiers(player *Player) {
+	kept := make([]CostModifier, 0, len(player.CostModifiers))
+	for _, modifier := range player.CostModifiers {
+		if modifier.Duration != BuffThisTurn {
+			kept = append(kept, modifier)
+		}
+	}
+	player.CostModifiers = kept
+}
@@ -0,0 +1,124 @@
+package game
+
+import (
+	"errors"
+
+	"github.com/openhs/internal/logger"
+)
+
+// DiscoverOptions is the number of cards offered by a Discover
+const DiscoverOptions = 3
+
+// Discover offers the player cards from the pool of registered cards matching filter
+// The effect is suspended until the player picks one, then onChoose continues it
+// and the rest of the card's resolution resumes
+// A nil onChoose adds the chosen card to the player's hand
+// If another choice is pending, this one is offered once that one is answered
+// Returns false if the pool is empty
+func (g *Game) Discover(player *Player, source *Entity, filter func(card *Card) bool, onChoose func(g *Game, chosen *Entity)) bool {
+	pool := GetCardManager().CardPool(filter)
+	if len(pool) == 0 {
+		logger.Debug("Discover pool is empty")
+		return false
+	}
+
+	// Pick distinct cards with the game's random source
+	options := make([]*Entity, 0, DiscoverOptions)
+	for len(options) < DiscoverOptions && len(pool) > 0 {
+		i := g.RandomInt(len(pool))
+		options = append(options, NewEntity(pool[i], g, player))
+		pool = append(pool[:i], pool[i+1:]...)
+	}
+
+	if onChoose == nil {
+		onChoose = func(g *Game, chosen *Entity) {
+			g.AddEntityToHand(player, chosen, -1)
+		}
+	}
+
+	choice := &PendingChoice{
+		Type:     ChoiceDiscover,
+		Player:   player,
+		Options:  options,
+		Source:   source,
+		OnChoose: onChoose,
+	}
+
+	if player.PendingChoice != nil || g.HasPendingChoice() {
+		g.queuedChoices = append(g.queuedChoices, choice)
+	} else {
+		player.PendingChoice = choice
+	}
+
+	logger.Info("Discover offered", logger.Int("options", len(options)))
+	return true
+}
+
+// HasPendingChoice checks if any player has to answer a Discover before the game can continue
+func (g *Game) HasPendingChoice() bool {
+	for _, player := range g.Players {
+		if player.PendingChoice != nil && player.PendingChoice.Type == ChoiceDiscover {
+			return true
+		}
+	}
+	return false
+}
+
+// Choose answers the player's pending Discover with the option at index
+// The suspended effect continues, then the next queued choice is offered
+// Once no choice is pending, the suspended resolution resumes
+func (g *Game) Choose(player *Player, index int) error {
+	choice := player.PendingChoice
+	if choice == nil || choice.Type != ChoiceDiscover {
+		return errors.New("player has no pending choice")
+	}
+	if index < 0 || index >= len(choice.Options) {
+		return errors.New("invalid choice index")
+	}
+
+	player.PendingChoice = nil
+	chosen := choice.Options[index]
+
+	// The cards that were not chosen never enter the game
+	for _, option := range choice.Options {
+		if option != chosen {
+			g.TriggerManager.UnregisterAllForEntity(option)
+		}
+	}
+
+	logger.Info("Discover chosen", logger.String("name", chosen.Card.Name))
+	choice.OnChoose(g, chosen)
+
+	// Offer the next choice, unless the effect just offered a new one
+	if !g.HasPendingChoice() && len(g.queuedChoices) > 0 {
+		next := g.queuedChoices[0]
+		g.queuedChoices = g.queuedChoices[1:]
+		next.Player.PendingChoice = next
+	}
+
+	if !g.HasPendingChoice() {
+		steps := g.suspendedSteps
+		g.suspendedSteps = nil
+		g.resolve(steps)
+	}
+
+	// Deaths wait until the resolution is complete
+	if !g.HasPendingChoice() {
+		g.ProcessDestroyAndUpdateAura()
+	}
+	return nil
+}
+
+// resolve runs the steps of an effect in order
+// If a step leaves a choice pending, the remaining steps are suspended until Choose resumes them
+func (g *Game) resolve(steps []func()) {
+	for i, step := range steps {
+		step()
+		if g.HasPendingChoice() {
+			// Steps suspended earlier resume after the ones of this effect
+			remaining := append([]func(){}, steps[i+1:]...)
+			g.suspendedSteps = append(remaining, g.suspendedSteps...)
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package game
+
+import "github.com/openhs/internal/logger"
+
+// IsDormant checks if an entity is dormant
+// Dormant minions cannot attack, be attacked or be targeted, and their triggers are off
+func IsDormant(entity *Entity) bool {
+	return entity != nil && HasTag(entity.Tags, TAG_DORMANT)
+}
+
+// ProcessDormant counts down the dormant minions of a player, called at the start of the player's turn
+// The TAG_DORMANT value holds the number of turns left, a minion awakens when it reaches 0
+// A dormant tag without a positive count has no countdown, the minion stays dormant until Awaken is called
+func (g *Game) ProcessDormant(player *Player) {
+	// Copy the field, awaken triggers may change it
+	minions := make([]*Entity, len(player.Field))
+	copy(minions, player.Field)
+
+	for _, minion := range minions {
+		if !IsDormant(minion) || minion.CurrentZone != ZONE_PLAY {
+			continue
+		}
+
+		turns := GetTagInt(minion.Tags, TAG_DORMANT)
+		if turns <= 0 {
+			logger.Debug("Dormant minion has no countdown", logger.String("name", minion.Card.Name))
+			continue
+		}
+
+		turns--
+		if turns > 0 {
+			SetTag(&minion.Tags, TAG_DORMANT, turns)
+			continue
+		}
+
+		g.Awaken(minion)
+	}
+}
+
+// Awaken ends the dormancy of a minion and fires the awaken triggers
+func (g *Game) Awaken(minion *Entity) {
+	if !RemoveTag(&minion.Tags, TAG_DORMANT) {
+		return
+	}
+
+	logger.Info("Minion awakened", logger.String("name", minion.Card.Name))
+
+	awakenCtx := TriggerContext{
+		Game:         g,
+		SourceEntity: minion,
+		Phase:        g.Phase,
+	}
+	g.TriggerManager.ActivateTrigger(TriggerMinionAwaken, awakenCtx)
+}
@@ -6,7 +6,10 @@ type Entity struct {
 	Owner             *Player
 	Health            int
 	MaxHealth         int
+	BaseHealth        int // Base health buffs apply to instead of the card's, 0 uses the card's health
 	Attack            int
+	Cost              int    // Cost of the card including buffs
+	Armor             int    // Armor of a hero, absorbs damage before health
 	Tags              []Tag  // Store entity states like Taunt, Divine Shield, etc.
 	Buffs             []Buff // Track any modifications specific to this instance
 	IsDestroyed       bool
@@ -14,6 +17,8 @@ type Entity struct {
 	Exhausted         bool // Indicates if the entity can attack or not this turn
 	NumTurnInPlay     int  // Tracks how many turns the entity has been in field (0 = first turn)
 	CurrentZone       Zone // Tracks which zone the entity is in
+	PlayOrder         int  // Order in which the entity entered play, used to resolve simultaneous deaths
+	LastFieldPosition int  // Field slot the minion occupied when it left play
 }
 
 // NewEntity creates a new entity from a card
@@ -24,6 +29,7 @@ func NewEntity(card *Card, game *Game, owner *Player) *Entity {
 		Health:      card.Health,
 		MaxHealth:   card.Health,
 		Attack:      card.Attack,
+		Cost:        card.Cost,
 		Tags:        make([]Tag, 0, len(card.Tags)),
 		Buffs:       make([]Buff, 0),
 		CurrentZone: ZONE_NONE, // Initial zone is NONE until placed somewhere
@@ -39,3 +45,25 @@ func NewEntity(card *Card, game *Game, owner *Player) *Entity {
 
 	return entity
 }
+
+// CopyEntity creates a copy of an entity for a player
+// The copy keeps the base health, buffs, tags and damage of the original
+func (g *Game) CopyEntity(entity *Entity, owner *Player) *Entity {
+	copied := NewEntity(entity.Card, g, owner)
+	copied.BaseHealth = entity.BaseHealth
+	copied.Buffs = append(copied.Buffs, entity.Buffs...)
+	copied.Tags = append(copied.Tags[:0], entity.Tags...)
+	g.RecomputeStats(copied)
+	copied.Health = entity.Health
+	return copied
+}
+
+// ResetEntity returns an entity to its base card
+// Buffs are dropped, tags are restored from the card and health is full
+func (g *Game) ResetEntity(entity *Entity) {
+	entity.BaseHealth = 0
+	entity.Buffs = make([]Buff, 0)
+	entity.Tags = append(make([]Tag, 0, len(entity.Card.Tags)), entity.Card.Tags...)
+	g.RecomputeStats(entity)
+	entity.Health = entity.MaxHealth
+}
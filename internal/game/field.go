@@ -20,6 +20,8 @@ func (g *Game) AddEntityToField(player *Player, entity *Entity, fieldPos int) bo
 	oldZone := entity.CurrentZone
 	entity.CurrentZone = ZONE_PLAY
 
+	entity.PlayOrder = g.nextPlayOrder()
+
 	// Reset attack and turn counters
 	entity.NumAttackThisTurn = 0
 	entity.NumTurnInPlay = 0 // First turn in play
@@ -59,6 +61,21 @@ func (g *Game) AddEntityToField(player *Player, entity *Entity, fieldPos int) bo
 	return true
 }
 
+// Summon creates a minion from a card and puts it on the player's field
+// Returns nil if the card does not exist or the field is full
+func (g *Game) Summon(player *Player, cardName string, fieldPos int) *Entity {
+	card, err := GetCardManager().CreateCardInstance(cardName)
+	if err != nil {
+		return nil
+	}
+
+	entity := NewEntity(card, g, player)
+	if !g.AddEntityToField(player, entity, fieldPos) {
+		return nil
+	}
+	return entity
+}
+
 // AddEntityToHand adds an entity to a player's hand
 // handPos is the position in the hand (-1 for end)
 // If hand is full, the entity is moved to ZONE_REMOVEDFROMGAME and returns false
@@ -132,13 +149,11 @@ func (g *Game) MoveFromDeckToField(player *Player, deckIndex, fieldPos int) bool
 
 // Helper to remove entity from board
 func (g *Game) removeEntityFromBoard(player *Player, entity *Entity) {
-	// Find the entity in the player's field and remove it
+	// Find the entity in the player's field and remove it, keeping the order of the others
 	for i, fieldEntity := range player.Field {
 		if fieldEntity == entity {
-			// Remove the entity by replacing it with the last element and then trimming the slice
-			lastIdx := len(player.Field) - 1
-			player.Field[i] = player.Field[lastIdx]
-			player.Field = player.Field[:lastIdx]
+			entity.LastFieldPosition = i
+			player.Field = append(player.Field[:i], player.Field[i+1:]...)
 			break
 		}
 	}
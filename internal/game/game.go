@@ -3,7 +3,9 @@ package game
 import (
 	"encoding/json"
 	"fmt"
+	"math/rand"
 	"os"
+	"time"
 
 	"github.com/openhs/internal/logger"
 )
@@ -15,6 +17,13 @@ type Game struct {
 	CurrentPlayerIndex int
 	Phase              GamePhase
 	TriggerManager     *TriggerManager
+	SkipMulligan       bool  // Go straight from the opening draw to the first turn
+	Seed               int64 // Seed of the game's random source, same seed and actions give the same game
+	rng                *rand.Rand
+	playCounter        int              // Counter for Entity.PlayOrder
+	pendingReborn      []*Entity        // Minions with reborn that died in the last death pass
+	queuedChoices      []*PendingChoice // Discover choices waiting for the current one to be answered
+	suspendedSteps     []func()         // Remaining steps of effects paused by a pending choice
 }
 
 type GamePhase int
@@ -93,18 +102,51 @@ func (p GamePhase) String() string {
 	}
 }
 
+// NewGame creates a new game with a random seed
 func NewGame() *Game {
-	return &Game{
+	return NewGameWithSeed(time.Now().UnixNano())
+}
+
+// NewGameWithSeed creates a new game whose random source uses the given seed
+func NewGameWithSeed(seed int64) *Game {
+	g := &Game{
 		Players:        make([]*Player, 0),
 		CurrentTurn:    0,
 		Phase:          InvalidPhase,
 		TriggerManager: NewTriggerManager(),
 	}
+	g.SetSeed(seed)
+
+	logger.Info("Game created", logger.Int64("seed", seed))
+
+	return g
+}
+
+// nextPlayOrder returns the play order for an entity entering play
+func (g *Game) nextPlayOrder() int {
+	g.playCounter++
+	return g.playCounter
+}
+
+// Opponent returns the other player of a two player game
+func (g *Game) Opponent(player *Player) *Player {
+	for _, p := range g.Players {
+		if p != player {
+			return p
+		}
+	}
+	return nil
 }
 
 // LoadGame creates a new game from a configuration
 func LoadGame(config *GameConfig) (*Game, error) {
-	g := NewGame()
+	var g *Game
+	if config.Seed != 0 {
+		g = NewGameWithSeed(config.Seed)
+	} else {
+		g = NewGame()
+	}
+	g.SkipMulligan = config.SkipMulligan
 
 	// Create players based on configuration
 	for _, playerConfig := range config.Players {
@@ -122,6 +164,12 @@ func LoadGame(config *GameConfig) (*Game, error) {
 		heroEntity := NewEntity(heroCardTemplate, g, player)
 		player.Hero = heroEntity
 
+		// Load the hero power linked from the hero card
+		if err := g.LoadHeroPower(player); err != nil {
+			logger.Error("Failed to load hero power: " + err.Error())
+			return nil, err
+		}
+
 		// Load deck cards
 		for _, cardName := range playerConfig.Deck {
 			cardTemplate, err := cardManager.CreateCardInstance(cardName)
@@ -143,7 +191,9 @@ func LoadGame(config *GameConfig) (*Game, error) {
 
 // GameConfig represents the configuration for a game
 type GameConfig struct {
-	Players []PlayerConfig `json:"players"`
+	Players      []PlayerConfig `json:"players"`
+	SkipMulligan bool           `json:"skip_mulligan,omitempty"`
+	Seed         int64          `json:"seed,omitempty"` // 0 means a random seed
 }
 
 // PlayerConfig represents the configuration for a player
@@ -5,17 +5,39 @@ import (
 )
 
 // DealDamage deals damage to a target from a source
+// Returns the amount of damage actually dealt
 // note: source may be nil
 // note: this function will not destroy the entity, that is handled elsewhere
-func (g *Game) DealDamage(source *Entity, target *Entity, amount int) {
+func (g *Game) DealDamage(source *Entity, target *Entity, amount int) int {
 	if target == nil {
 		logger.Debug("DealDamage: target is nil, skipping")
-		return
+		return 0
 	}
 
 	if amount <= 0 {
 		logger.Debug("DealDamage: amount is <= 0, skipping")
-		return
+		return 0
+	}
+
+	// Immune characters ignore all damage
+	if HasTag(target.Tags, TAG_IMMUNE) {
+		logger.Debug("Immune target ignored damage", logger.String("target", target.Card.Name))
+		return 0
+	}
+
+	// Divine shield absorbs the whole damage instance
+	if RemoveTag(&target.Tags, TAG_DIVINE_SHIELD) {
+		logger.Debug("Divine shield absorbed damage", logger.String("target", target.Card.Name))
+
+		shieldCtx := TriggerContext{
+			Game:         g,
+			SourceEntity: source,
+			TargetEntity: target,
+			Value:        amount,
+			Phase:        g.Phase,
+		}
+		g.TriggerManager.ActivateTrigger(TriggerDivineShieldLost, shieldCtx)
+		return 0
 	}
 
 	// Create context for damage trigger
@@ -27,8 +49,13 @@ func (g *Game) DealDamage(source *Entity, target *Entity, amount int) {
 		Phase:        g.Phase,
 	}
 
-	// Deal damage
-	target.Health -= amount
+	// Deal damage, armor is lost before health
+	target.Health -= absorbWithArmor(target, amount)
+
+	// Dealing damage reveals a stealthed source
+	if source != nil {
+		g.removeStealth(source)
+	}
 
 	// Trigger damage taken event
 	g.TriggerManager.ActivateTrigger(TriggerDamageTaken, damageCtx)
@@ -52,6 +79,25 @@ func (g *Game) DealDamage(source *Entity, target *Entity, amount int) {
 			g.Heal(source, source.Owner.Hero, amount)
 		}
 	}
+
+	return amount
+}
+
+// Destroy marks an entity for destruction, it leaves play in the next death pass
+// Immune characters ignore destroy effects
+// Returns true if the entity was marked
+func (g *Game) Destroy(target *Entity) bool {
+	if target == nil {
+		return false
+	}
+
+	if HasTag(target.Tags, TAG_IMMUNE) {
+		logger.Debug("Immune target ignored destroy effect", logger.String("target", target.Card.Name))
+		return false
+	}
+
+	target.IsDestroyed = true
+	return true
 }
 
 // Heal heals a character by the specified amount
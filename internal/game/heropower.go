@@ -0,0 +1,89 @@
+package game
+
+import (
+	"errors"
+
+	"github.com/openhs/internal/logger"
+)
+
+// LoadHeroPower gives the player the hero power linked from its hero card
+// Heroes without a linked hero power leave the current one in place
+func (g *Game) LoadHeroPower(player *Player) error {
+	if player.Hero == nil || player.Hero.Card.HeroPower == "" {
+		return nil
+	}
+
+	card, err := GetCardManager().CreateCardInstance(player.Hero.Card.HeroPower)
+	if err != nil {
+		return err
+	}
+
+	if player.HeroPower != nil {
+		player.HeroPower.CurrentZone = ZONE_REMOVEDFROMGAME
+	}
+
+	heroPower := NewEntity(card, g, player)
+	heroPower.CurrentZone = ZONE_PLAY
+	player.HeroPower = heroPower
+
+	return nil
+}
+
+// TestUseHeroPower checks if the player can use the hero power on the target
+// target may be nil for hero powers without a target
+func (g *Game) TestUseHeroPower(player *Player, target *Entity) error {
+	heroPower := player.HeroPower
+	if heroPower == nil {
+		return errors.New("player has no hero power")
+	}
+
+	// A hero power can be used once per turn
+	if heroPower.Exhausted {
+		return errors.New("hero power already used this turn")
+	}
+
+	if err := g.canPayCost(player, heroPower); err != nil {
+		return err
+	}
+
+	if err := g.checkBoardRequirements(player, heroPower.Card.Requirements); err != nil {
+		return err
+	}
+
+	return g.checkPlayTarget(player, heroPower, target, heroPower.Card.Requirements)
+}
+
+// UseHeroPower uses the player's hero power
+func (g *Game) UseHeroPower(player *Player, target *Entity) error {
+	if err := g.TestUseHeroPower(player, target); err != nil {
+		return err
+	}
+
+	heroPower := player.HeroPower
+
+	// Pay the cost and exhaust the hero power until the next turn
+	if err := g.payCost(player, heroPower); err != nil {
+		return err
+	}
+	heroPower.Exhausted = true
+
+	logger.Info("Hero power used", logger.String("name", heroPower.Card.Name))
+
+	for _, power := range heroPower.Card.Powers {
+		if power.Type == PowerTypeHeroPower {
+			power.Action(g, heroPower, target)
+		}
+	}
+
+	heroPowerCtx := TriggerContext{
+		Game:         g,
+		SourceEntity: heroPower,
+		TargetEntity: target,
+		Phase:        g.Phase,
+	}
+	g.TriggerManager.ActivateTrigger(TriggerHeroPowerUsed, heroPowerCtx)
+
+	g.ProcessDestroyAndUpdateAura()
+
+	return nil
+}
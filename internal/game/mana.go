@@ -0,0 +1,20 @@
+package game
+
+import (
+	"github.com/openhs/internal/logger"
+)
+
+// GainTemporaryMana gives a player mana crystals that only last for the current turn
+// Mana cannot exceed MaxMana
+func (g *Game) GainTemporaryMana(player *Player, amount int) {
+	if amount <= 0 {
+		return
+	}
+
+	player.Mana += amount
+	if player.Mana > player.MaxMana {
+		player.Mana = player.MaxMana
+	}
+
+	logger.Debug("Temporary mana gained", logger.Int("amount", amount), logger.Int("mana", player.Mana))
+}
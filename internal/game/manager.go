@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io/fs"
 	"path/filepath"
+	"sort"
 
 	"github.com/openhs/internal/logger"
 )
@@ -51,6 +52,25 @@ func (cm *CardManager) CreateCardInstance(name string) (*Card, error) {
 	return &card, nil
 }
 
+// CardPool returns instances of every registered card matching the filter, sorted by name
+// The order is fixed so that random picks from the pool only depend on the game's seed
+func (cm *CardManager) CardPool(filter func(card *Card) bool) []*Card {
+	names := make([]string, 0, len(cm.cardTemplates))
+	for name := range cm.cardTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pool := make([]*Card, 0)
+	for _, name := range names {
+		card := cm.cardTemplates[name]
+		if filter == nil || filter(&card) {
+			pool = append(pool, &card)
+		}
+	}
+	return pool
+}
+
 // GetCardTemplate returns a card template by name
 func (cm *CardManager) GetCardTemplate(name string) (*Card, error) {
 	logger.Debug("Retrieving card template", logger.String("name", name))
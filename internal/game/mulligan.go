@@ -0,0 +1,82 @@
+package game
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/openhs/internal/logger"
+)
+
+// OfferMulligan creates a pending mulligan choice listing the player's opening hand
+func (g *Game) OfferMulligan(player *Player) {
+	options := make([]*Entity, len(player.Hand))
+	copy(options, player.Hand)
+
+	player.PendingChoice = &PendingChoice{
+		Type:    ChoiceMulligan,
+		Player:  player,
+		Options: options,
+	}
+}
+
+// Mulligan replaces the cards at the given hand indices with new cards from the deck
+// The replaced cards are put back at the bottom of the deck before the new cards are drawn,
+// so they are only drawn again if the deck runs out, then the deck is shuffled
+func (g *Game) Mulligan(player *Player, replaceIndices []int) error {
+	if player.PendingChoice == nil || player.PendingChoice.Type != ChoiceMulligan {
+		return errors.New("player has no pending mulligan")
+	}
+
+	// Validate indices
+	seen := make(map[int]bool, len(replaceIndices))
+	for _, index := range replaceIndices {
+		if index < 0 || index >= len(player.Hand) {
+			return errors.New("invalid hand index")
+		}
+		if seen[index] {
+			return errors.New("duplicate hand index")
+		}
+		seen[index] = true
+	}
+
+	// Remove the replaced cards from hand, highest index first to keep the rest valid
+	indices := make([]int, len(replaceIndices))
+	copy(indices, replaceIndices)
+	sort.Sort(sort.Reverse(sort.IntSlice(indices)))
+
+	replaced := make([]*Entity, 0, len(indices))
+	for _, index := range indices {
+		entity := player.Hand[index]
+		player.Hand = append(player.Hand[:index], player.Hand[index+1:]...)
+		replaced = append(replaced, entity)
+	}
+
+	// Put the replaced cards back at the bottom of the deck
+	for _, entity := range replaced {
+		entity.CurrentZone = ZONE_DECK
+		player.Deck = append([]*Entity{entity}, player.Deck...)
+	}
+
+	// Draw the same number of new cards
+	for range replaced {
+		g.DrawCard(player)
+	}
+
+	// Shuffle the deck with the game's random source
+	g.ShuffleDeck(player)
+
+	logger.Info("Mulligan completed", logger.Int("replaced", len(replaced)))
+
+	player.PendingChoice = nil
+	return nil
+}
+
+// HasPendingMulligan checks if any player has not submitted their mulligan yet
+func (g *Game) HasPendingMulligan() bool {
+	for _, player := range g.Players {
+		if player.PendingChoice != nil && player.PendingChoice.Type == ChoiceMulligan {
+			return true
+		}
+	}
+	return false
+}
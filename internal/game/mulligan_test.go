@@ -0,0 +1,60 @@
+package game
+
+import (
+	"testing"
+)
+
+// TestMulligan tests the Game.Mulligan function
+func TestMulligan(t *testing.T) {
+	g := CreateTestGame()
+	player := g.Players[0]
+
+	for i := 0; i < 3; i++ {
+		g.DrawCard(player)
+	}
+
+	// Test 1: Mulligan without a pending choice should fail
+	if err := g.Mulligan(player, nil); err == nil {
+		t.Fatal("Expected an error when no mulligan is pending, but got nil")
+	}
+
+	g.OfferMulligan(player)
+
+	// Test 2: Invalid and duplicate indices should fail
+	if err := g.Mulligan(player, []int{3}); err == nil {
+		t.Error("Expected an error for an out of range index, but got nil")
+	}
+	if err := g.Mulligan(player, []int{1, 1}); err == nil {
+		t.Error("Expected an error for duplicate indices, but got nil")
+	}
+
+	// Test 3: Replaced cards go back to the deck before new cards are drawn
+	replaced := player.Hand[1]
+	if err := g.Mulligan(player, []int{1}); err != nil {
+		t.Fatalf("Mulligan returned an error: %v", err)
+	}
+
+	if len(player.Hand) != 3 {
+		t.Errorf("Expected hand size to be 3, got %d", len(player.Hand))
+	}
+	if len(player.Deck) != 7 {
+		t.Errorf("Expected deck size to be 7, got %d", len(player.Deck))
+	}
+	for _, entity := range player.Hand {
+		if entity == replaced {
+			t.Errorf("Expected replaced card to leave the hand")
+		}
+	}
+	inDeck := false
+	for _, entity := range player.Deck {
+		if entity == replaced {
+			inDeck = true
+		}
+	}
+	if !inDeck || replaced.CurrentZone != ZONE_DECK {
+		t.Errorf("Expected replaced card to be shuffled back into the deck")
+	}
+	if player.PendingChoice != nil {
+		t.Errorf("Expected pending choice to be cleared")
+	}
+}
@@ -12,7 +12,7 @@ import (
 // - handIndex: The index of the card in the player's hand
 // - target: Optional target for the card (can be nil)
 // - fieldPos: Position on the field for minions (-1 for auto-positioning)
-// - chooseOne: Index for choose one effects (0 for default choice)
+// - chooseOne: Index of the chosen option for Choose One cards (ignored by other cards)
 func (g *Game) PlayCard(player *Player, handIndex int, target *Entity, fieldPos int, chooseOne int) error {
 	// Validate hand index
 	if handIndex < 0 || handIndex >= len(player.Hand) {
@@ -32,13 +32,9 @@ func (g *Game) PlayCard(player *Player, handIndex int, target *Entity, fieldPos
 		return errors.New("battlefield is full")
 	}
 
-	// Spend mana to play the card
-	if entity.Card.Cost > 0 {
-		// TODO: Handle temporary mana and special cost modifiers
-		if player.Mana < entity.Card.Cost {
-			return errors.New("not enough mana")
-		}
-		player.Mana -= entity.Card.Cost
+	// Spend mana, or health for cards that cost health, to play the card
+	if err := g.payCost(player, entity); err != nil {
+		return err
 	}
 
 	// Record play history and update game state
@@ -68,13 +64,28 @@ func (g *Game) PlayCard(player *Player, handIndex int, target *Entity, fieldPos
 // TestPlayCard checks if a card can be played
 func (g *Game) TestPlayCard(player *Player, entity *Entity, target *Entity, chooseOne int) error {
 	// Basic checks
-	if entity.Card.Cost > player.Mana {
-		return errors.New("not enough mana")
+	if err := g.canPayCost(player, entity); err != nil {
+		return err
 	}
 
-	// TODO: Check card-specific play requirements and target validity
+	// Secrets need room in the secret zone
+	if IsSecret(entity) {
+		if err := g.checkSecret(player, entity); err != nil {
+			return err
+		}
+	}
 
-	return nil
+	// Choose One cards need a valid option
+	if _, err := g.chosenOptions(player, entity, chooseOne); err != nil {
+		return err
+	}
+
+	// Check the board and the chosen target against the card's play requirements
+	reqs := g.PlayRequirements(player, entity, chooseOne)
+	if err := g.checkBoardRequirements(player, reqs); err != nil {
+		return err
+	}
+	return g.checkPlayTarget(player, entity, target, reqs)
 }
 
 // PlayMinion handles playing a minion card
@@ -93,12 +104,70 @@ func (g *Game) PlayMinion(player *Player, entity *Entity, target *Entity, fieldP
 
 	// Try to add minion to the field at the specified position
 	if g.AddEntityToField(player, entity, fieldPos) {
-		// TODO: Process battlecry, triggers, etc.
+		steps := g.battlecrySteps(player, entity, target, chooseOne)
+		steps = append(steps, func() {
+			// Trigger minion played event
+			minionPlayedCtx := TriggerContext{
+				Game:         g,
+				SourceEntity: entity,
+				TargetEntity: target,
+				Phase:        g.Phase,
+			}
+			g.TriggerManager.ActivateTrigger(TriggerMinionPlayed, minionPlayedCtx)
+
+			g.ProcessDestroyAndUpdateAura()
+		})
+		g.resolve(steps)
+	}
 
+	return nil
+}
+
+// battlecrySteps returns the steps that run the battlecry powers of a minion placed on the field
+// Choose One minions run the powers of the chosen option
+func (g *Game) battlecrySteps(player *Player, entity *Entity, target *Entity, chooseOne int) []func() {
+	// A battlecry that needs a target does nothing if none was available
+	if target == nil && takesTarget(g.PlayRequirements(player, entity, chooseOne)) {
+		logger.Debug("Battlecry has no target, skipping", logger.String("name", entity.Card.Name))
 		return nil
 	}
 
-	return nil
+	steps := make([]func(), 0)
+	stopped := false
+	for i := 0; i < g.BattlecryCount(player); i++ {
+		// The target may have left play during the previous run
+		steps = append(steps, func() {
+			if target != nil && target.CurrentZone != ZONE_PLAY {
+				stopped = true
+			}
+		})
+
+		for _, power := range g.playPowers(player, entity, chooseOne) {
+			if power.Type != PowerTypeBattlecry {
+				continue
+			}
+			steps = append(steps, func() {
+				if !stopped {
+					power.Action(g, entity, target)
+				}
+			})
+		}
+	}
+	return steps
+}
+
+// BattlecryCount returns how many times the player's battlecries trigger
+// Battlecries trigger twice if the player's hero or a friendly minion that is not dormant has TAG_BATTLECRY_TWICE
+func (g *Game) BattlecryCount(player *Player) int {
+	if player.Hero != nil && HasTag(player.Hero.Tags, TAG_BATTLECRY_TWICE) {
+		return 2
+	}
+	for _, minion := range player.Field {
+		if HasTag(minion.Tags, TAG_BATTLECRY_TWICE) && !IsDormant(minion) {
+			return 2
+		}
+	}
+	return 1
 }
 
 // PlaySpell handles playing a spell card
@@ -119,19 +188,44 @@ func (g *Game) PlaySpell(player *Player, entity *Entity, target *Entity, chooseO
 	// Trigger card played event
 	g.TriggerManager.ActivateTrigger(TriggerCardPlayed, cardPlayedCtx)
 
+	// A countered spell goes to the graveyard without effect
+	if HasTag(entity.Tags, TAG_COUNTERED) {
+		RemoveTag(&entity.Tags, TAG_COUNTERED)
+		player.Graveyard = append(player.Graveyard, entity)
+		entity.CurrentZone = ZONE_GRAVEYARD
+		g.ProcessDestroyAndUpdateAura()
+		return nil
+	}
+
+	// Secrets go to the secret zone instead of resolving
+	if IsSecret(entity) {
+		if !g.AddSecret(player, entity) {
+			player.Graveyard = append(player.Graveyard, entity)
+			entity.CurrentZone = ZONE_GRAVEYARD
+		}
+		g.ProcessDestroyAndUpdateAura()
+		return nil
+	}
+
 	// Process spell effects
-	for _, power := range entity.Card.Powers {
+	steps := make([]func(), 0)
+	for _, power := range g.playPowers(player, entity, chooseOne) {
 		if power.Type == PowerTypeSpell {
-			power.Action(g, entity, target)
+			steps = append(steps, func() {
+				power.Action(g, entity, target)
+			})
 		}
 	}
-	g.processDestroyAndUpdateAura()
+	steps = append(steps, func() {
+		g.ProcessDestroyAndUpdateAura()
 
-	// Move to graveyard after use
-	player.Graveyard = append(player.Graveyard, entity)
+		// Move to graveyard after use
+		player.Graveyard = append(player.Graveyard, entity)
 
-	// Update the entity's zone
-	entity.CurrentZone = ZONE_GRAVEYARD
+		// Update the entity's zone
+		entity.CurrentZone = ZONE_GRAVEYARD
+	})
+	g.resolve(steps)
 
 	return nil
 }
@@ -150,12 +244,23 @@ func (g *Game) PlayHero(player *Player, entity *Entity, target *Entity, chooseOn
 	entity.NumAttackThisTurn = player.Hero.NumAttackThisTurn
 	entity.NumTurnInPlay = 0 // First turn in play
 
+	// Keep the old hero's armor
+	entity.Armor = player.Hero.Armor
+
 	// Set the new hero
 	player.Hero = entity
 
 	// Update the entity's zone
 	entity.CurrentZone = ZONE_PLAY
 
+	// Replace the hero power if the new hero brings one
+	if err := g.LoadHeroPower(player); err != nil {
+		logger.Warn("Failed to load hero power", logger.String("hero", entity.Card.Name), logger.Err(err))
+	}
+
+	// Hero cards grant their armor on play
+	g.GainArmor(entity, entity.Card.Armor)
+
 	logger.Info("Hero replaced", logger.String("name", entity.Card.Name))
 
 	return nil
@@ -14,6 +14,7 @@ type Player struct {
 	Hero      *Entity
 	HeroPower *Entity
 	Weapon    *Entity
+	Secrets   []*Entity
 
 	Mana          int
 	MaxMana       int
@@ -21,6 +22,10 @@ type Player struct {
 	FatigueDamage int
 	HandSize      int
 	FieldSize     int
+
+	CostModifiers []CostModifier // One-shot changes to the cost of the next matching card
+	PendingChoice *PendingChoice // Decision the player has to make before the game can continue
+	PlayState     PlayState      // Whether the player is still playing or how their game ended
 }
 
 // NewPlayer creates a new player from a configuration
@@ -30,6 +35,7 @@ func NewPlayer() *Player {
 		Hand:      make([]*Entity, 0),
 		Field:     make([]*Entity, 0),
 		Graveyard: make([]*Entity, 0),
+		Secrets:   make([]*Entity, 0),
 		HandSize:  10,
 		FieldSize: 7,
 		MaxMana:   DefaultMaxMana,
@@ -0,0 +1,45 @@
+package game
+
+import (
+	"math/rand"
+)
+
+// All randomness in a game must go through these helpers so that
+// games with the same seed and the same actions play out the same way
+
+// SetSeed resets the game's random source with the given seed
+func (g *Game) SetSeed(seed int64) {
+	g.Seed = seed
+	g.rng = rand.New(rand.NewSource(seed))
+}
+
+// RandomInt returns a random number in [0, n)
+// Returns 0 if n <= 0
+func (g *Game) RandomInt(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return g.rng.Intn(n)
+}
+
+// RandomEntity returns a random entity from the list, or nil if the list is empty
+func (g *Game) RandomEntity(entities []*Entity) *Entity {
+	if len(entities) == 0 {
+		return nil
+	}
+	return entities[g.RandomInt(len(entities))]
+}
+
+// ShuffleDeck shuffles the player's deck
+func (g *Game) ShuffleDeck(player *Player) {
+	g.rng.Shuffle(len(player.Deck), func(i, j int) {
+		player.Deck[i], player.Deck[j] = player.Deck[j], player.Deck[i]
+	})
+}
+
+// ShuffleIntoDeck puts an entity into a random position of the player's deck
+func (g *Game) ShuffleIntoDeck(player *Player, entity *Entity) {
+	pos := g.RandomInt(len(player.Deck) + 1)
+	player.Deck = append(player.Deck[:pos], append([]*Entity{entity}, player.Deck[pos:]...)...)
+	entity.CurrentZone = ZONE_DECK
+}
@@ -0,0 +1,92 @@
+package game
+
+import (
+	"fmt"
+	"testing"
+)
+
+// createNamedDeck fills the player's deck with distinguishable cards
+func createNamedDeck(g *Game, player *Player, size int) {
+	player.Deck = nil
+	for i := 0; i < size; i++ {
+		entity := CreateTestMinionEntity(g, player, WithName(fmt.Sprintf("Card %d", i)))
+		entity.CurrentZone = ZONE_DECK
+		player.Deck = append(player.Deck, entity)
+	}
+}
+
+// TestShuffleDeckDeterministic tests that the same seed shuffles a deck the same way
+func TestShuffleDeckDeterministic(t *testing.T) {
+	g1 := NewGameWithSeed(12345)
+	g2 := NewGameWithSeed(12345)
+	p1 := NewPlayer()
+	p2 := NewPlayer()
+	createNamedDeck(g1, p1, 30)
+	createNamedDeck(g2, p2, 30)
+
+	g1.ShuffleDeck(p1)
+	g2.ShuffleDeck(p2)
+
+	changed := false
+	for i := range p1.Deck {
+		if p1.Deck[i].Card.Name != p2.Deck[i].Card.Name {
+			t.Fatalf("Expected same deck order for the same seed, position %d differs: %s vs %s",
+				i, p1.Deck[i].Card.Name, p2.Deck[i].Card.Name)
+		}
+		if p1.Deck[i].Card.Name != fmt.Sprintf("Card %d", i) {
+			changed = true
+		}
+	}
+	if !changed {
+		t.Errorf("Expected the deck order to change after shuffling")
+	}
+
+	// Subsequent random numbers stay in sync as well
+	for i := 0; i < 10; i++ {
+		if g1.RandomInt(100) != g2.RandomInt(100) {
+			t.Fatalf("Expected the same random sequence for the same seed")
+		}
+	}
+}
+
+// TestShuffleIntoDeck tests that ShuffleIntoDeck puts the entity into the deck
+func TestShuffleIntoDeck(t *testing.T) {
+	g := CreateTestGame()
+	player := g.Players[0]
+	entity := CreateTestMinionEntity(g, player, WithName("Shuffled"))
+
+	initialDeckSize := len(player.Deck)
+	g.ShuffleIntoDeck(player, entity)
+
+	if len(player.Deck) != initialDeckSize+1 {
+		t.Fatalf("Expected deck size to be %d, got %d", initialDeckSize+1, len(player.Deck))
+	}
+	if entity.CurrentZone != ZONE_DECK {
+		t.Errorf("Expected entity zone to be DECK, got %s", entity.CurrentZone)
+	}
+
+	found := false
+	for _, deckEntity := range player.Deck {
+		if deckEntity == entity {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected entity to be in the deck")
+	}
+}
+
+// TestRandomIntRange tests that RandomInt stays in range
+func TestRandomIntRange(t *testing.T) {
+	g := NewGameWithSeed(1)
+
+	if g.RandomInt(0) != 0 {
+		t.Errorf("Expected RandomInt(0) to return 0")
+	}
+	for i := 0; i < 100; i++ {
+		n := g.RandomInt(3)
+		if n < 0 || n >= 3 {
+			t.Fatalf("Expected RandomInt(3) to be in [0, 3), got %d", n)
+		}
+	}
+}
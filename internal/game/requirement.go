@@ -0,0 +1,125 @@
+package game
+
+import (
+	"errors"
+)
+
+// PlayRequirement represents a condition a card declares for being played
+type PlayRequirement int
+
+// Play requirement constants, the value in Card.Requirements holds the parameter if any
+const (
+	REQ_TARGET_TO_PLAY        PlayRequirement = iota // A target must be chosen
+	REQ_TARGET_IF_AVAILABLE                          // A target must be chosen if any valid target exists
+	REQ_NUM_MINION_SLOTS                             // The player's field needs at least this many free slots
+	REQ_MINION_TARGET                                // The target must be a minion
+	REQ_ENEMY_TARGET                                 // The target must be controlled by an opponent
+	REQ_FRIENDLY_TARGET                              // The target must be controlled by the player
+	REQ_DAMAGED_TARGET                               // The target must be damaged
+	REQ_MINIMUM_ENEMY_MINIONS                        // Opponents need at least this many minions on the field
+	REQ_MINIMUM_TOTAL_MINIONS                        // Both fields together need at least this many minions
+)
+
+// HasRequirement checks if a card declares a play requirement
+func (c *Card) HasRequirement(req PlayRequirement) bool {
+	_, ok := c.Requirements[req]
+	return ok
+}
+
+// TakesTarget checks if a card chooses a target when played
+func (c *Card) TakesTarget() bool {
+	return takesTarget(c.Requirements)
+}
+
+// checkBoardRequirements checks the requirements reqs that depend on the board rather than the target
+func (g *Game) checkBoardRequirements(player *Player, reqs map[PlayRequirement]int) error {
+	if slots, ok := reqs[REQ_NUM_MINION_SLOTS]; ok {
+		if player.FieldSize-len(player.Field) < slots {
+			return errors.New("not enough room on the field")
+		}
+	}
+
+	enemyMinions, totalMinions := 0, 0
+	for _, p := range g.Players {
+		if p != player {
+			enemyMinions += len(p.Field)
+		}
+		totalMinions += len(p.Field)
+	}
+	if count, ok := reqs[REQ_MINIMUM_ENEMY_MINIONS]; ok && enemyMinions < count {
+		return errors.New("not enough enemy minions")
+	}
+	if count, ok := reqs[REQ_MINIMUM_TOTAL_MINIONS]; ok && totalMinions < count {
+		return errors.New("not enough minions")
+	}
+	return nil
+}
+
+// ValidPlayTargets returns every character that can be chosen as the target of the card
+func (g *Game) ValidPlayTargets(player *Player, entity *Entity) []*Entity {
+	return g.validPlayTargets(player, entity, entity.Card.Requirements)
+}
+
+// validPlayTargets returns every character that satisfies the targeting requirements reqs
+func (g *Game) validPlayTargets(player *Player, entity *Entity, reqs map[PlayRequirement]int) []*Entity {
+	targets := make([]*Entity, 0)
+	for _, p := range g.Players {
+		characters := append([]*Entity{p.Hero}, p.Field...)
+		for _, character := range characters {
+			if character == nil || character == entity {
+				continue
+			}
+			if g.validatePlayTarget(player, entity, character, reqs) == nil {
+				targets = append(targets, character)
+			}
+		}
+	}
+	return targets
+}
+
+// validatePlayTarget checks if a single character can be chosen as the target of the card
+func (g *Game) validatePlayTarget(player *Player, entity *Entity, target *Entity, reqs map[PlayRequirement]int) error {
+	if target.Card.Type != Minion && target.Card.Type != Hero {
+		return errors.New("target must be a minion or hero")
+	}
+	if target.CurrentZone != ZONE_PLAY {
+		return errors.New("target is not in play")
+	}
+	if _, ok := reqs[REQ_MINION_TARGET]; ok && target.Card.Type != Minion {
+		return errors.New("target must be a minion")
+	}
+	if _, ok := reqs[REQ_ENEMY_TARGET]; ok && target.Owner == player {
+		return errors.New("target must be an enemy")
+	}
+	if _, ok := reqs[REQ_FRIENDLY_TARGET]; ok && target.Owner != player {
+		return errors.New("target must be friendly")
+	}
+	if _, ok := reqs[REQ_DAMAGED_TARGET]; ok && target.Health >= target.MaxHealth {
+		return errors.New("target must be damaged")
+	}
+	return g.CanBeTargetedBy(entity, target)
+}
+
+// checkPlayTarget checks the chosen target against the targeting requirements reqs
+// reqs are the card's requirements, including those of the chosen Choose One option
+func (g *Game) checkPlayTarget(player *Player, entity *Entity, target *Entity, reqs map[PlayRequirement]int) error {
+	_, toPlay := reqs[REQ_TARGET_TO_PLAY]
+	_, ifAvailable := reqs[REQ_TARGET_IF_AVAILABLE]
+
+	if target == nil {
+		if toPlay {
+			return errors.New("card requires a target")
+		}
+		if ifAvailable && len(g.validPlayTargets(player, entity, reqs)) > 0 {
+			return errors.New("a target must be chosen")
+		}
+		return nil
+	}
+
+	// Cards that do not declare targeting cannot be played with a target
+	if !takesTarget(reqs) {
+		return errors.New("card does not take a target")
+	}
+
+	return g.validatePlayTarget(player, entity, target, reqs)
+}
@@ -0,0 +1,106 @@
+package game
+
+import (
+	"github.com/openhs/internal/logger"
+)
+
+// PlayState represents whether a player is still playing or how their game ended
+type PlayState int
+
+const (
+	PlayStatePlaying PlayState = iota
+	PlayStateWon
+	PlayStateLost
+	PlayStateTied
+	PlayStateConceded
+)
+
+// String returns a string representation of the PlayState
+func (s PlayState) String() string {
+	switch s {
+	case PlayStatePlaying:
+		return "Playing"
+	case PlayStateWon:
+		return "Won"
+	case PlayStateLost:
+		return "Lost"
+	case PlayStateTied:
+		return "Tied"
+	case PlayStateConceded:
+		return "Conceded"
+	default:
+		return "Unknown"
+	}
+}
+
+// IsGameOver checks if any player has finished the game
+func (g *Game) IsGameOver() bool {
+	for _, player := range g.Players {
+		if player.PlayState != PlayStatePlaying {
+			return true
+		}
+	}
+	return false
+}
+
+// Winner returns the player who won the game, or nil if the game is not over or is a draw
+func (g *Game) Winner() *Player {
+	for _, player := range g.Players {
+		if player.PlayState == PlayStateWon {
+			return player
+		}
+	}
+	return nil
+}
+
+// CheckHeroDeaths updates the players' play states when heroes have died
+// If all heroes die at the same time the game is a draw
+// Returns true if the game is over
+func (g *Game) CheckHeroDeaths() bool {
+	if g.IsGameOver() {
+		return true
+	}
+
+	dead := 0
+	for _, player := range g.Players {
+		if isHeroDead(player) {
+			dead++
+		}
+	}
+
+	if dead == 0 {
+		return false
+	}
+
+	for _, player := range g.Players {
+		switch {
+		case dead == len(g.Players):
+			player.PlayState = PlayStateTied
+		case isHeroDead(player):
+			player.PlayState = PlayStateLost
+		default:
+			player.PlayState = PlayStateWon
+		}
+	}
+
+	logger.Info("Game over", logger.Int("dead_heroes", dead))
+	return true
+}
+
+// Concede ends the game with the player losing and all other players winning
+func (g *Game) Concede(player *Player) {
+	for _, p := range g.Players {
+		if p == player {
+			p.PlayState = PlayStateConceded
+		} else {
+			p.PlayState = PlayStateWon
+		}
+	}
+
+	logger.Info("Player conceded")
+}
+
+// isHeroDead checks if the player's hero has been killed
+func isHeroDead(player *Player) bool {
+	return player.Hero != nil && (player.Hero.Health <= 0 || player.Hero.IsDestroyed)
+}
@@ -0,0 +1,88 @@
+package game
+
+import (
+	"testing"
+)
+
+// TestCheckHeroDeaths tests the Game.CheckHeroDeaths function
+func TestCheckHeroDeaths(t *testing.T) {
+	// Test 1: No hero died
+	g := CreateTestGame()
+	if g.CheckHeroDeaths() {
+		t.Fatal("Expected game not to be over when both heroes are alive")
+	}
+	if g.IsGameOver() {
+		t.Fatal("Expected IsGameOver to be false")
+	}
+
+	// Test 2: One hero died
+	g.Players[1].Hero.Health = 0
+	if !g.CheckHeroDeaths() {
+		t.Fatal("Expected game to be over when a hero died")
+	}
+	if g.Players[0].PlayState != PlayStateWon {
+		t.Errorf("Expected player 1 to have won, got %s", g.Players[0].PlayState)
+	}
+	if g.Players[1].PlayState != PlayStateLost {
+		t.Errorf("Expected player 2 to have lost, got %s", g.Players[1].PlayState)
+	}
+	if g.Winner() != g.Players[0] {
+		t.Errorf("Expected player 1 to be the winner")
+	}
+
+	// Test 3: Both heroes died at once
+	g = CreateTestGame()
+	g.Players[0].Hero.Health = -1
+	g.Players[1].Hero.Health = 0
+	if !g.CheckHeroDeaths() {
+		t.Fatal("Expected game to be over when both heroes died")
+	}
+	for i, player := range g.Players {
+		if player.PlayState != PlayStateTied {
+			t.Errorf("Expected player %d to be tied, got %s", i+1, player.PlayState)
+		}
+	}
+	if g.Winner() != nil {
+		t.Errorf("Expected no winner in a draw")
+	}
+}
+
+// TestHeroDeathFromAttack tests that the death pass after an attack ends the game
+func TestHeroDeathFromAttack(t *testing.T) {
+	g := CreateTestGame()
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+
+	attacker := CreateTestMinionEntity(g, player1, WithAttack(5))
+	g.AddEntityToField(player1, attacker, -1)
+	attacker.Exhausted = false
+	player2.Hero.Health = 5
+
+	if err := g.Attack(attacker, player2.Hero, false); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+
+	if player2.PlayState != PlayStateLost {
+		t.Errorf("Expected player 2 to have lost, got %s", player2.PlayState)
+	}
+	if g.Winner() != player1 {
+		t.Errorf("Expected player 1 to be the winner")
+	}
+}
+
+// TestConcede tests the Game.Concede function
+func TestConcede(t *testing.T) {
+	g := CreateTestGame()
+
+	g.Concede(g.Players[0])
+
+	if g.Players[0].PlayState != PlayStateConceded {
+		t.Errorf("Expected player 1 to have conceded, got %s", g.Players[0].PlayState)
+	}
+	if g.Winner() != g.Players[1] {
+		t.Errorf("Expected player 2 to be the winner")
+	}
+	if !g.CheckHeroDeaths() {
+		t.Errorf("Expected game to be over after concede")
+	}
+}
@@ -0,0 +1,78 @@
+package game
+
+import (
+	"errors"
+
+	"github.com/openhs/internal/logger"
+)
+
+// MaxSecrets is the number of secrets a player can have in play at once
+const MaxSecrets = 5
+
+// IsSecret checks if an entity is a secret card
+func IsSecret(entity *Entity) bool {
+	return entity != nil && HasTag(entity.Card.Tags, TAG_SECRET)
+}
+
+// checkSecret checks if a player can put a secret into play
+// The secret zone holds at most MaxSecrets secrets and no two with the same name
+func (g *Game) checkSecret(player *Player, entity *Entity) error {
+	if len(player.Secrets) >= MaxSecrets {
+		return errors.New("secret zone is full")
+	}
+	for _, secret := range player.Secrets {
+		if secret.Card.Name == entity.Card.Name {
+			return errors.New("secret is already in play")
+		}
+	}
+	return nil
+}
+
+// AddSecret puts a secret into the player's secret zone
+// Returns false if the secret zone is full or already has this secret
+func (g *Game) AddSecret(player *Player, entity *Entity) bool {
+	if err := g.checkSecret(player, entity); err != nil {
+		logger.Debug("Secret not added", logger.String("name", entity.Card.Name), logger.Err(err))
+		return false
+	}
+
+	player.Secrets = append(player.Secrets, entity)
+	entity.CurrentZone = ZONE_SECRET
+	logger.Info("Secret put into play", logger.String("name", entity.Card.Name))
+	return true
+}
+
+// SecretActive checks if a secret can trigger
+// Secrets only trigger while in the secret zone and on the opponent's turn
+func (g *Game) SecretActive(secret *Entity) bool {
+	return secret.CurrentZone == ZONE_SECRET && g.CurrentPlayer != secret.Owner
+}
+
+// RevealSecret reveals a secret that fires and moves it to the graveyard
+// Secret cards call this before resolving their effect
+func (g *Game) RevealSecret(secret *Entity) {
+	player := secret.Owner
+	for i, s := range player.Secrets {
+		if s == secret {
+			player.Secrets = append(player.Secrets[:i], player.Secrets[i+1:]...)
+			break
+		}
+	}
+
+	player.Graveyard = append(player.Graveyard, secret)
+	secret.CurrentZone = ZONE_GRAVEYARD
+	logger.Info("Secret revealed", logger.String("name", secret.Card.Name))
+
+	revealCtx := TriggerContext{
+		Game:         g,
+		SourceEntity: secret,
+		Phase:        g.Phase,
+	}
+	g.TriggerManager.ActivateTrigger(TriggerSecretRevealed, revealCtx)
+}
+
+// Counter stops a spell that is being cast from resolving
+func (g *Game) Counter(spell *Entity) {
+	SetTag(&spell.Tags, TAG_COUNTERED, true)
+	logger.Info("Spell countered", logger.String("name", spell.Card.Name))
+}
@@ -0,0 +1,47 @@
+package game
+
+import "github.com/openhs/internal/logger"
+
+// statusTags are tags that describe the state of an entity rather than its card text
+// They survive silence, every other tag counts as card text or an effect and is removed
+// note: Frozen is removed by silence, like in Hearthstone
+var statusTags = map[TagType]bool{
+	TAG_DORMANT:  true,
+	TAG_SILENCED: true,
+}
+
+// IsSilenced checks if an entity has been silenced
+func IsSilenced(entity *Entity) bool {
+	return entity != nil && HasTag(entity.Tags, TAG_SILENCED)
+}
+
+// Silence removes the card text and all effects of a minion
+// Triggers are unloaded, tags other than status tags are cleared, buffs are dropped
+// and attack and health are recomputed from the base card
+func (g *Game) Silence(target *Entity) {
+	if target == nil || target.Card.Type != Minion {
+		return
+	}
+
+	logger.Info("Minion silenced", logger.String("name", target.Card.Name))
+
+	// Remove triggers of the card text, then any triggers effects registered on the minion
+	if target.Card.Unload != nil {
+		target.Card.Unload(g, target)
+	}
+	g.TriggerManager.UnregisterAllForEntity(target)
+
+	// Keep status tags only
+	tags := make([]Tag, 0, len(target.Tags))
+	for _, tag := range target.Tags {
+		if statusTags[tag.Type] {
+			tags = append(tags, tag)
+		}
+	}
+	target.Tags = tags
+	SetTag(&target.Tags, TAG_SILENCED, true)
+
+	// Drop buffs and recompute stats from the base card
+	target.Buffs = make([]Buff, 0)
+	g.RecomputeStats(target)
+}
@@ -0,0 +1,78 @@
+package game
+
+import "github.com/openhs/internal/logger"
+
+// SpellDamage returns the total Spell Damage of a player
+// Sums TAG_SPELLPOWER values of the player's hero, weapon and minions
+func (g *Game) SpellDamage(player *Player) int {
+	total := 0
+	for _, entity := range spellDamageSources(player) {
+		total += GetTagInt(entity.Tags, TAG_SPELLPOWER)
+	}
+	return total
+}
+
+// SpellDamageMultiplier returns the multiplier applied to a player's spell damage
+// Every entity with TAG_SPELLPOWER_DOUBLE doubles it once more
+func (g *Game) SpellDamageMultiplier(player *Player) int {
+	multiplier := 1
+	for _, entity := range spellDamageSources(player) {
+		if HasTag(entity.Tags, TAG_SPELLPOWER_DOUBLE) {
+			multiplier *= 2
+		}
+	}
+	return multiplier
+}
+
+// CalculateSpellDamage returns the damage a spell of the player deals for a base amount
+// Spell Damage is added first, then doubling modifiers are applied
+func (g *Game) CalculateSpellDamage(player *Player, amount int) int {
+	if player == nil {
+		return amount
+	}
+	return (amount + g.SpellDamage(player)) * g.SpellDamageMultiplier(player)
+}
+
+// DealSpellDamage deals damage from a spell, including the owner's Spell Damage
+// Returns the amount of damage actually dealt
+func (g *Game) DealSpellDamage(source *Entity, target *Entity, amount int) int {
+	if source != nil {
+		boosted := g.CalculateSpellDamage(source.Owner, amount)
+		if boosted != amount {
+			logger.Debug("Spell damage modified",
+				logger.String("source", source.Card.Name),
+				logger.Int("base", amount),
+				logger.Int("amount", boosted))
+		}
+		amount = boosted
+	}
+	return g.DealDamage(source, target, amount)
+}
+
+// DealHeroPowerDamage deals damage from a hero power, including the owner's Spell Damage
+// Like spells, Spell Damage is added first, then doubling modifiers are applied
+// Returns the amount of damage actually dealt
+func (g *Game) DealHeroPowerDamage(source *Entity, target *Entity, amount int) int {
+	if source != nil {
+		amount = g.CalculateSpellDamage(source.Owner, amount)
+	}
+	return g.DealDamage(source, target, amount)
+}
+
+// spellDamageSources returns the entities of a player that can carry Spell Damage
+// Dormant minions are skipped, their effects are off until they awaken
+func spellDamageSources(player *Player) []*Entity {
+	sources := make([]*Entity, 0, len(player.Field)+2)
+	if player.Hero != nil {
+		sources = append(sources, player.Hero)
+	}
+	if player.Weapon != nil {
+		sources = append(sources, player.Weapon)
+	}
+	for _, minion := range player.Field {
+		if !IsDormant(minion) {
+			sources = append(sources, minion)
+		}
+	}
+	return sources
+}
@@ -23,6 +23,13 @@ const (
 	TAG_CANT_ATTACK
 	TAG_CANT_BE_TARGETED
 	TAG_IMMUNE
+	TAG_BATTLECRY_TWICE
+	TAG_SPELLPOWER_DOUBLE
+	TAG_SILENCED
+	TAG_SECRET
+	TAG_COUNTERED
+	TAG_CHOOSE_BOTH
+	TAG_COSTS_HEALTH
 )
 
 // Tag represents a key-value pair for entity attributes in Hearthstone
@@ -59,6 +66,30 @@ func GetTagValue(tags []Tag, tagType TagType) (interface{}, bool) {
 	return nil, false
 }
 
+// GetTagInt returns the integer value of a specific tag
+// Returns 0 if the tag is missing or does not hold an int
+func GetTagInt(tags []Tag, tagType TagType) int {
+	value, ok := GetTagValue(tags, tagType)
+	if !ok {
+		return 0
+	}
+	if n, ok := value.(int); ok {
+		return n
+	}
+	return 0
+}
+
+// SetTag sets the value of a tag, adding the tag if it does not exist yet
+func SetTag(tags *[]Tag, tagType TagType, value interface{}) {
+	for i, tag := range *tags {
+		if tag.Type == tagType {
+			(*tags)[i].Value = value
+			return
+		}
+	}
+	*tags = append(*tags, NewTag(tagType, value))
+}
+
 // RemoveTag removes a tag of the specified type from a list of tags if it exists
 // Returns true if a tag was removed, false otherwise
 func RemoveTag(tags *[]Tag, tagType TagType) bool {
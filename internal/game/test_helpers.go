@@ -26,13 +26,14 @@ func CreateTestGame() *Game {
 	player2 := CreateTestPlayer(g)
 
 	g.Players = append(g.Players, player1, player2)
-	
+
 	// Set up basic game state
 	g.Phase = MainAction
 	g.CurrentTurn = 1
 	g.CurrentPlayerIndex = 0
 	g.CurrentPlayer = g.Players[0]
-	
+	g.SkipMulligan = true
+
 	return g
 }
 
@@ -105,32 +106,55 @@ func CreateTestHeroEntity(g *Game, player *Player, opts ...func(*Card)) *Entity
 }
 
 // Helper functions for common entity customizations
+
+// WithName sets the name of a test card
 func WithName(name string) func(*Card) {
 	return func(c *Card) {
 		c.Name = name
 	}
 }
 
+// WithCost sets the cost of a test card
 func WithCost(cost int) func(*Card) {
 	return func(c *Card) {
 		c.Cost = cost
 	}
 }
 
+// WithAttack sets the attack of a test card
 func WithAttack(attack int) func(*Card) {
 	return func(c *Card) {
 		c.Attack = attack
 	}
 }
 
+// WithHealth sets the health of a test card
 func WithHealth(health int) func(*Card) {
 	return func(c *Card) {
 		c.Health = health
 	}
 }
 
+// WithTag adds a tag to a test card
 func WithTag(tagType TagType, value interface{}) func(*Card) {
 	return func(c *Card) {
 		c.Tags = append(c.Tags, NewTag(tagType, value))
 	}
-} 
\ No newline at end of file
+}
+
+// WithPower adds a power of the given type to a test card
+func WithPower(powerType PowerType, action func(g *Game, source, target *Entity)) func(*Card) {
+	return func(c *Card) {
+		c.Powers = append(c.Powers, Power{Type: powerType, Action: action})
+	}
+}
+
+// WithRequirement adds a play requirement with its parameter to a test card
+func WithRequirement(req PlayRequirement, value int) func(*Card) {
+	return func(c *Card) {
+		if c.Requirements == nil {
+			c.Requirements = make(map[PlayRequirement]int)
+		}
+		c.Requirements[req] = value
+	}
+}
@@ -13,20 +13,25 @@ const (
 	// Card triggers
 	TriggerCardPlayed
 	TriggerCardDrawn
+	TriggerSecretRevealed
 
 	// Combat triggers
 	TriggerBeforeAttack
 	TriggerAfterAttack
 	TriggerDamageTaken
 	TriggerHealReceived
+	TriggerDivineShieldLost
 
 	// Minion triggers
 	TriggerMinionSummoned
+	TriggerMinionPlayed // After a minion is played and its battlecry resolved
 	TriggerMinionDeath
+	TriggerMinionAwaken
 
 	// Hero triggers
 	TriggerHeroDamageTaken
 	TriggerHeroPowerUsed
+	TriggerArmorGained
 
 	// More triggers can be added here
 
@@ -44,6 +49,8 @@ func (t TriggerType) String() string {
 		return "TriggerCardPlayed"
 	case TriggerCardDrawn:
 		return "TriggerCardDrawn"
+	case TriggerSecretRevealed:
+		return "TriggerSecretRevealed"
 	case TriggerBeforeAttack:
 		return "TriggerBeforeAttack"
 	case TriggerAfterAttack:
@@ -52,14 +59,22 @@ func (t TriggerType) String() string {
 		return "TriggerDamageTaken"
 	case TriggerHealReceived:
 		return "TriggerHealReceived"
+	case TriggerDivineShieldLost:
+		return "TriggerDivineShieldLost"
 	case TriggerMinionSummoned:
 		return "TriggerMinionSummoned"
+	case TriggerMinionPlayed:
+		return "TriggerMinionPlayed"
 	case TriggerMinionDeath:
 		return "TriggerMinionDeath"
+	case TriggerMinionAwaken:
+		return "TriggerMinionAwaken"
 	case TriggerHeroDamageTaken:
 		return "TriggerHeroDamageTaken"
 	case TriggerHeroPowerUsed:
 		return "TriggerHeroPowerUsed"
+	case TriggerArmorGained:
+		return "TriggerArmorGained"
 	default:
 		return "UnknownTrigger"
 	}
@@ -171,6 +186,11 @@ func (tm *TriggerManager) ActivateTrigger(triggerType TriggerType, ctx TriggerCo
 	logger.Debug("Activating triggers", logger.String("triggerType", triggerType.String()), logger.Int("count", len(triggersToActivate)))
 
 	for _, reg := range triggersToActivate {
+		// Triggers of dormant entities stay off until they awaken
+		if reg.RegisteredOn != nil && IsDormant(reg.RegisteredOn) {
+			continue
+		}
+
 		reg.Callback(&ctx, reg.RegisteredOn)
 
 		// If the trigger is one-time only, remove it after activation
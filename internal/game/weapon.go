@@ -8,16 +8,24 @@ import (
 
 // PlayWeapon handles playing a weapon card
 func (g *Game) PlayWeapon(player *Player, entity *Entity, target *Entity) error {
-	// If player already has a weapon, move it to graveyard
-	if player.Weapon != nil {
-		player.Graveyard = append(player.Graveyard, player.Weapon)
-		player.Weapon.CurrentZone = ZONE_GRAVEYARD
-		logger.Debug("Weapon moved to graveyard", logger.String("name", player.Weapon.Card.Name))
+	// If player already has a weapon, it is destroyed
+	oldWeapon := player.Weapon
+	if oldWeapon != nil {
+		player.Graveyard = append(player.Graveyard, oldWeapon)
+		oldWeapon.CurrentZone = ZONE_GRAVEYARD
+		logger.Debug("Weapon moved to graveyard", logger.String("name", oldWeapon.Card.Name))
 	}
 
 	// Equip the new weapon
 	player.Weapon = entity
 	entity.CurrentZone = ZONE_PLAY
+	entity.PlayOrder = g.nextPlayOrder()
+
+	// The replaced weapon's deathrattle resolves after the new one is equipped
+	if oldWeapon != nil {
+		g.processDeathrattle(oldWeapon)
+		g.ProcessDestroyAndUpdateAura()
+	}
 	logger.Debug("Weapon equipped", logger.String("name", entity.Card.Name))
 
 	return nil
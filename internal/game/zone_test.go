@@ -308,7 +308,6 @@ func TestZoneTrackingDuringCombat(t *testing.T) {
 		WithHealth(1)) // 1 durability
 
 	player1.Weapon = weapon
-	player1.Hero.Attack = 1
 	weapon.CurrentZone = ZONE_PLAY
 
 	// Have the hero attack to use the weapon
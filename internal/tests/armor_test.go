@@ -0,0 +1,103 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestArmor(t *testing.T) {
+	t.Run("Armor absorbs damage before health", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		hero := g.Players[0].Hero
+		initialHealth := hero.Health
+		g.GainArmor(hero, 5)
+
+		g.DealDamage(nil, hero, 3)
+		if hero.Armor != 2 || hero.Health != initialHealth {
+			t.Errorf("Expected 2 armor and %d health, got %d armor and %d health", initialHealth, hero.Armor, hero.Health)
+		}
+
+		g.DealDamage(nil, hero, 4)
+		if hero.Armor != 0 || hero.Health != initialHealth-2 {
+			t.Errorf("Expected 0 armor and %d health, got %d armor and %d health", initialHealth-2, hero.Armor, hero.Health)
+		}
+	})
+
+	t.Run("Damage absorbed by armor still counts as damage taken", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		hero := g.Players[0].Hero
+		g.GainArmor(hero, 5)
+
+		taken := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerHeroDamageTaken, nil, func(ctx *game.TriggerContext, self *game.Entity) {
+			taken += ctx.Value
+		}, false)
+
+		if dealt := g.DealDamage(nil, hero, 2); dealt != 2 {
+			t.Errorf("Expected 2 damage dealt, got %d", dealt)
+		}
+		if taken != 2 {
+			t.Errorf("Expected hero damage trigger with 2 damage, got %d", taken)
+		}
+	})
+
+	t.Run("Gaining armor fires a trigger", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		hero := g.Players[0].Hero
+
+		gained := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerArmorGained, nil, func(ctx *game.TriggerContext, self *game.Entity) {
+			if ctx.TargetEntity == hero {
+				gained += ctx.Value
+			}
+		}, false)
+
+		g.GainArmor(hero, 3)
+		g.GainArmor(hero, 0)
+
+		if gained != 3 {
+			t.Errorf("Expected armor trigger with 3 armor, got %d", gained)
+		}
+	})
+
+	t.Run("Playing a hero card grants armor and keeps the old armor", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player1.Mana = 10
+		g.GainArmor(player1.Hero, 2)
+
+		heroCard := game.CreateTestHeroEntity(g, player1, func(c *game.Card) {
+			c.Armor = 5
+		})
+		g.AddEntityToHand(player1, heroCard, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play hero card: %v", err)
+		}
+
+		if player1.Hero != heroCard {
+			t.Fatalf("Expected hero card to become the player's hero")
+		}
+		if player1.Hero.Armor != 7 {
+			t.Errorf("Expected hero to have 7 armor, got %d", player1.Hero.Armor)
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+// withAura gives a test card an aura
+func withAura(affects func(g *game.Game, source, target *game.Entity) bool, buff game.Buff) func(*game.Card) {
+	return func(c *game.Card) {
+		c.Auras = append(c.Auras, game.Aura{Affects: affects, Buff: buff})
+	}
+}
+
+func TestAura(t *testing.T) {
+	t.Run("Aura grants tags to other friendly minions", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player, game.WithName("Test Minion"))
+		source := game.CreateTestMinionEntity(g, player,
+			game.WithName("Aura Minion"),
+			withAura(game.OtherFriendlyMinions, game.Buff{Tags: []game.Tag{game.NewTag(game.TAG_TAUNT, true)}}))
+		e.AddEntityToField(player, minion, -1)
+		e.AddEntityToField(player, source, -1)
+		g.UpdateAuras()
+
+		if !game.HasTag(minion.Tags, game.TAG_TAUNT) {
+			t.Errorf("Expected other minion to have taunt")
+		}
+		if game.HasTag(source.Tags, game.TAG_TAUNT) {
+			t.Errorf("Expected aura source not to have taunt")
+		}
+
+		g.Silence(source)
+		g.UpdateAuras()
+
+		if game.HasTag(minion.Tags, game.TAG_TAUNT) {
+			t.Errorf("Expected taunt to be removed after the source is silenced")
+		}
+	})
+
+	t.Run("Aura applies to minions entering play", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		source := game.CreateTestMinionEntity(g, player,
+			game.WithName("Aura Minion"),
+			withAura(game.OtherFriendlyMinions, game.Buff{Attack: 1, Health: 1}))
+		e.AddEntityToField(player, source, -1)
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithCost(1),
+			game.WithAttack(2),
+			game.WithHealth(2))
+		player.Hand = []*game.Entity{minion}
+		player.Mana = 10
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if minion.Attack != 3 || minion.Health != 3 {
+			t.Errorf("Expected played minion to be 3/3, got %d/%d", minion.Attack, minion.Health)
+		}
+	})
+
+	t.Run("Refreshing a health aura keeps damage", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithHealth(3))
+		source := game.CreateTestMinionEntity(g, player,
+			game.WithName("Aura Minion"),
+			withAura(game.OtherFriendlyMinions, game.Buff{Health: 1}))
+		e.AddEntityToField(player, minion, -1)
+		e.AddEntityToField(player, source, -1)
+		g.UpdateAuras()
+
+		g.DealDamage(nil, minion, 1)
+		g.UpdateAuras()
+
+		if minion.Health != 3 || minion.MaxHealth != 4 {
+			t.Errorf("Expected 3 health with max health 4, got %d and %d", minion.Health, minion.MaxHealth)
+		}
+
+		// Losing the aura keeps the remaining health if it fits
+		source.IsDestroyed = true
+		g.ProcessDestroyAndUpdateAura()
+
+		if minion.Health != 3 || minion.MaxHealth != 3 {
+			t.Errorf("Expected 3 health with max health 3, got %d and %d", minion.Health, minion.MaxHealth)
+		}
+	})
+
+	t.Run("Auras are updated at the start of the turn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		source := game.CreateTestMinionEntity(g, opponent,
+			game.WithName("Aura Minion"),
+			withAura(game.FriendlySpellsInHand, game.Buff{Cost: -1}))
+		e.AddEntityToField(opponent, source, -1)
+
+		// The opponent's drawn card gets the aura when their turn starts
+		spell := game.CreateTestSpellEntity(g, opponent, game.WithName("Test Spell"), game.WithCost(2))
+		opponent.Deck = append(opponent.Deck, spell)
+		e.EndPlayerTurn()
+
+		if g.CurrentPlayer != opponent {
+			t.Fatalf("Expected opponent's turn")
+		}
+		if spell.CurrentZone != game.ZONE_HAND || spell.Cost != 1 {
+			t.Errorf("Expected drawn spell in hand with cost 1, got zone %s and cost %d", spell.CurrentZone, spell.Cost)
+		}
+	})
+}
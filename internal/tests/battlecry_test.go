@@ -0,0 +1,153 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestBattlecry(t *testing.T) {
+	t.Run("Battlecry runs after the minion is placed", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player1.Mana = 10
+
+		var zoneDuringBattlecry game.Zone
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Battlecry Minion"),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {
+				zoneDuringBattlecry = source.CurrentZone
+			}))
+		g.AddEntityToHand(player1, minion, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if zoneDuringBattlecry != game.ZONE_PLAY {
+			t.Errorf("Expected minion to be in play during its battlecry, got %s", zoneDuringBattlecry)
+		}
+	})
+
+	t.Run("Battlecry receives the chosen target", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+
+		enemy := game.CreateTestMinionEntity(g, player2, game.WithName("Enemy Minion"), game.WithHealth(5))
+		g.AddEntityToField(player2, enemy, 0)
+
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Battlecry Minion"),
+			game.WithRequirement(game.REQ_TARGET_IF_AVAILABLE, 0),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {
+				g.DealDamage(source, target, 2)
+			}))
+		g.AddEntityToHand(player1, minion, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, enemy, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if enemy.Health != 3 {
+			t.Errorf("Expected enemy minion health to be 3 after battlecry, got %d", enemy.Health)
+		}
+	})
+
+	t.Run("Target must be chosen if one is available", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player1.Mana = 10
+
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Battlecry Minion"),
+			game.WithRequirement(game.REQ_TARGET_IF_AVAILABLE, 0),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {}))
+		g.AddEntityToHand(player1, minion, -1)
+
+		// Heroes are always valid targets, so a target is required
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err == nil {
+			t.Errorf("Expected playing without a target to fail when targets are available")
+		}
+	})
+
+	t.Run("Minion can be played without a valid target", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+
+		// Make every character an invalid target
+		player1.Hero.CurrentZone = game.ZONE_NONE
+		player2.Hero.Tags = append(player2.Hero.Tags, game.NewTag(game.TAG_STEALTH, true))
+
+		ran := false
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Battlecry Minion"),
+			game.WithRequirement(game.REQ_TARGET_IF_AVAILABLE, 0),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {
+				ran = true
+			}))
+		g.AddEntityToHand(player1, minion, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err != nil {
+			t.Fatalf("Expected minion to be playable without a valid target, but got error: %v", err)
+		}
+
+		if ran {
+			t.Errorf("Expected battlecry not to run without a target")
+		}
+		if minion.CurrentZone != game.ZONE_PLAY {
+			t.Errorf("Expected minion to be in play, got %s", minion.CurrentZone)
+		}
+	})
+
+	t.Run("Battlecries trigger twice with TAG_BATTLECRY_TWICE", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player1.Mana = 10
+
+		brann := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Brann"),
+			game.WithTag(game.TAG_BATTLECRY_TWICE, true))
+		g.AddEntityToField(player1, brann, 0)
+
+		count := 0
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Battlecry Minion"),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {
+				count++
+			}))
+		g.AddEntityToHand(player1, minion, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if count != 2 {
+			t.Errorf("Expected battlecry to run twice, ran %d times", count)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestBuff(t *testing.T) {
+	t.Run("Buff changes attack and health", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, minion, -1)
+		minion.Health = 2 // Damaged by 1
+
+		g.AddBuff(minion, game.Buff{Attack: 1, Health: 2})
+
+		if minion.Attack != 3 || minion.MaxHealth != 5 || minion.Health != 4 {
+			t.Errorf("Expected 3/4 with max health 5, got %d/%d with max health %d", minion.Attack, minion.Health, minion.MaxHealth)
+		}
+	})
+
+	t.Run("Removing a health buff only caps health", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, minion, -1)
+
+		g.AddBuff(minion, game.Buff{Health: 2})
+		minion.Health = 4 // Damaged by 1
+
+		g.RemoveBuffs(minion, func(buff game.Buff) bool { return true })
+
+		if minion.MaxHealth != 3 || minion.Health != 3 {
+			t.Errorf("Expected 3 health and max health, got %d and %d", minion.Health, minion.MaxHealth)
+		}
+	})
+
+	t.Run("This turn buff expires at end of turn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, minion, -1)
+
+		g.AddBuff(minion, game.Buff{Attack: 3, Duration: game.BuffThisTurn})
+		g.AddBuff(minion, game.Buff{Attack: 1})
+		if minion.Attack != 6 {
+			t.Errorf("Expected attack 6, got %d", minion.Attack)
+		}
+
+		e.EndPlayerTurn()
+
+		if minion.Attack != 3 {
+			t.Errorf("Expected attack 3 after end of turn, got %d", minion.Attack)
+		}
+	})
+
+	t.Run("Until next turn buff lasts through the opponent's turn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, minion, -1)
+
+		g.AddBuff(minion, game.Buff{
+			Tags:     []game.Tag{game.NewTag(game.TAG_IMMUNE, true)},
+			Duration: game.BuffUntilNextTurn,
+		})
+
+		e.EndPlayerTurn()
+		if !game.HasTag(minion.Tags, game.TAG_IMMUNE) {
+			t.Errorf("Expected minion to be immune during the opponent's turn")
+		}
+
+		e.EndPlayerTurn()
+		if game.HasTag(minion.Tags, game.TAG_IMMUNE) {
+			t.Errorf("Expected immune to expire at the start of the player's turn")
+		}
+	})
+
+	t.Run("Removing a buff keeps tags from the card", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithTag(game.TAG_TAUNT, true))
+		e.AddEntityToField(player, minion, -1)
+
+		g.AddBuff(minion, game.Buff{Tags: []game.Tag{game.NewTag(game.TAG_TAUNT, true)}, Duration: game.BuffThisTurn})
+		g.ExpireTurnEffects()
+
+		if !game.HasTag(minion.Tags, game.TAG_TAUNT) {
+			t.Errorf("Expected minion to keep taunt from its card")
+		}
+	})
+
+	t.Run("Silence removes buffs and their tags", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, minion, -1)
+
+		g.AddBuff(minion, game.Buff{Attack: 2, Health: 2, Tags: []game.Tag{game.NewTag(game.TAG_TAUNT, true)}})
+		g.Silence(minion)
+
+		if minion.Attack != 2 || minion.Health != 3 || minion.MaxHealth != 3 {
+			t.Errorf("Expected 2/3 after silence, got %d/%d", minion.Attack, minion.Health)
+		}
+		if game.HasTag(minion.Tags, game.TAG_TAUNT) {
+			t.Errorf("Expected taunt from the buff to be removed")
+		}
+	})
+
+	t.Run("Copy keeps buffs and damage, reset drops them", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, minion, -1)
+		g.AddBuff(minion, game.Buff{Attack: 1, Health: 1})
+		minion.Health = 2
+
+		copied := g.CopyEntity(minion, player)
+		if copied.Attack != 3 || copied.Health != 2 || copied.MaxHealth != 4 || len(copied.Buffs) != 1 {
+			t.Errorf("Expected copy to be 3/2 with max health 4 and 1 buff, got %d/%d with max health %d and %d buffs",
+				copied.Attack, copied.Health, copied.MaxHealth, len(copied.Buffs))
+		}
+
+		g.ResetEntity(minion)
+		if minion.Attack != 2 || minion.Health != 3 || len(minion.Buffs) != 0 {
+			t.Errorf("Expected reset minion to be 2/3 with no buffs, got %d/%d with %d buffs",
+				minion.Attack, minion.Health, len(minion.Buffs))
+		}
+	})
+
+	t.Run("Cost buff changes mana paid", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Test Minion"),
+			game.WithCost(3))
+		player.Hand = []*game.Entity{minion}
+		player.Mana = 5
+
+		g.AddBuff(minion, game.Buff{Cost: -5})
+		if minion.Cost != 0 {
+			t.Errorf("Expected cost to be floored at 0, got %d", minion.Cost)
+		}
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+		if player.Mana != 5 {
+			t.Errorf("Expected no mana spent, got %d mana left", player.Mana)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestCantAttack(t *testing.T) {
+	t.Run("Minion with Can't Attack cannot attack", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithAttack(4), game.WithTag(game.TAG_CANT_ATTACK, true))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, player2.Hero, false); err == nil {
+			t.Errorf("Expected attack to fail for a minion that can't attack")
+		}
+
+		for _, action := range g.LegalActions(player1) {
+			if action.Type == game.ActionAttack && action.Source == attacker {
+				t.Errorf("Expected no legal attacks for a minion that can't attack")
+			}
+		}
+	})
+
+	t.Run("Minion with Can't Attack can still be attacked", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1, game.WithAttack(2))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		defender := game.CreateTestMinionEntity(g, player2,
+			game.WithAttack(0), game.WithHealth(5), game.WithTag(game.TAG_CANT_ATTACK, true))
+		g.AddEntityToField(player2, defender, 0)
+
+		if err := g.Attack(attacker, defender, false); err != nil {
+			t.Errorf("Expected attack to succeed, but got error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,164 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+// withChooseOne gives a test card Choose One options
+func withChooseOne(options ...game.ChooseOneOption) func(*game.Card) {
+	return func(c *game.Card) {
+		c.ChooseOne = options
+	}
+}
+
+// createChooseOneSpell creates a spell whose first option damages a target and whose second option draws a card
+func createChooseOneSpell(g *game.Game, player *game.Player, ran *[]string) *game.Entity {
+	return game.CreateTestSpellEntity(g, player,
+		game.WithName("Choose One Spell"),
+		game.WithCost(0),
+		withChooseOne(
+			game.ChooseOneOption{
+				Name:         "Damage",
+				Requirements: map[game.PlayRequirement]int{game.REQ_TARGET_TO_PLAY: 0},
+				Powers: []game.Power{{Type: game.PowerTypeSpell, Action: func(g *game.Game, source, target *game.Entity) {
+					*ran = append(*ran, "Damage")
+					if target != nil {
+						g.DealDamage(source, target, 2)
+					}
+				}}},
+			},
+			game.ChooseOneOption{
+				Name: "Draw",
+				Powers: []game.Power{{Type: game.PowerTypeSpell, Action: func(g *game.Game, source, target *game.Entity) {
+					*ran = append(*ran, "Draw")
+				}}},
+			},
+		))
+}
+
+func TestChooseOne(t *testing.T) {
+	t.Run("Chosen option runs with its own targeting", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		var ran []string
+		player.Hand = []*game.Entity{createChooseOneSpell(g, player, &ran)}
+
+		// The damage option needs a target
+		if err := e.PlayCard(player, 0, nil, -1, 0); err == nil {
+			t.Fatalf("Expected damage option without a target to fail")
+		}
+
+		initialHealth := opponent.Hero.Health
+		if err := e.PlayCard(player, 0, opponent.Hero, -1, 0); err != nil {
+			t.Fatalf("Failed to play damage option: %v", err)
+		}
+		if len(ran) != 1 || ran[0] != "Damage" {
+			t.Errorf("Expected only the damage option to run, got %v", ran)
+		}
+		if opponent.Hero.Health != initialHealth-2 {
+			t.Errorf("Expected opponent hero to take 2 damage")
+		}
+	})
+
+	t.Run("Option without targeting needs no target", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		var ran []string
+		player.Hand = []*game.Entity{createChooseOneSpell(g, player, &ran)}
+
+		if err := e.PlayCard(player, 0, nil, -1, 1); err != nil {
+			t.Fatalf("Failed to play draw option: %v", err)
+		}
+		if len(ran) != 1 || ran[0] != "Draw" {
+			t.Errorf("Expected only the draw option to run, got %v", ran)
+		}
+	})
+
+	t.Run("Invalid choice is rejected", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		var ran []string
+		player.Hand = []*game.Entity{createChooseOneSpell(g, player, &ran)}
+
+		if err := e.PlayCard(player, 0, nil, -1, 2); err == nil {
+			t.Errorf("Expected invalid choice to fail")
+		}
+	})
+
+	t.Run("Choose both runs every option", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Choose Both Minion"),
+			game.WithTag(game.TAG_CHOOSE_BOTH, true))
+		e.AddEntityToField(player, minion, -1)
+
+		var ran []string
+		player.Hand = []*game.Entity{createChooseOneSpell(g, player, &ran)}
+
+		// The combined card takes the requirements of both options
+		if err := e.PlayCard(player, 0, nil, -1, 1); err == nil {
+			t.Fatalf("Expected combined options without a target to fail")
+		}
+		if err := e.PlayCard(player, 0, opponent.Hero, -1, 1); err != nil {
+			t.Fatalf("Failed to play combined options: %v", err)
+		}
+		if len(ran) != 2 || ran[0] != "Damage" || ran[1] != "Draw" {
+			t.Errorf("Expected both options to run in order, got %v", ran)
+		}
+	})
+
+	t.Run("Legal actions list each option", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		var ran []string
+		player.Hand = []*game.Entity{createChooseOneSpell(g, player, &ran)}
+
+		damage, draw := 0, 0
+		for _, action := range e.LegalActions(player) {
+			if action.Type != game.ActionPlayCard {
+				continue
+			}
+			switch action.ChooseOne {
+			case 0:
+				if action.Target == nil {
+					t.Errorf("Expected damage option to have a target")
+				}
+				damage++
+			case 1:
+				if action.Target != nil {
+					t.Errorf("Expected draw option to have no target")
+				}
+				draw++
+			}
+		}
+		if damage == 0 || draw != 1 {
+			t.Errorf("Expected damage actions and one draw action, got %d and %d", damage, draw)
+		}
+	})
+}
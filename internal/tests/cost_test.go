@@ -0,0 +1,212 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+// spellsOnly makes a cost modifier apply to spells
+func spellsOnly(entity *game.Entity) bool {
+	return entity.Card.Type == game.Spell
+}
+
+func TestCostModification(t *testing.T) {
+	t.Run("Buffs and auras change the effective cost", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		spell := game.CreateTestSpellEntity(g, player, game.WithCost(3))
+		g.AddEntityToHand(player, spell, -1)
+
+		g.AddBuff(spell, game.Buff{Cost: -1})
+		if cost := g.EffectiveCost(spell); cost != 2 {
+			t.Errorf("Expected cost 2 with a buff, got %d", cost)
+		}
+
+		source := game.CreateTestMinionEntity(g, player, withAura(game.FriendlySpellsInHand, game.Buff{Cost: -1}))
+		g.AddEntityToField(player, source, -1)
+		g.UpdateAuras()
+		if cost := g.EffectiveCost(spell); cost != 1 {
+			t.Errorf("Expected cost 1 with a buff and an aura, got %d", cost)
+		}
+	})
+
+	t.Run("Next spell costs less once", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		player.Mana = 10
+
+		minion := game.CreateTestMinionEntity(g, player, game.WithCost(3))
+		first := game.CreateTestSpellEntity(g, player, game.WithCost(3))
+		second := game.CreateTestSpellEntity(g, player, game.WithCost(3))
+		player.Hand = []*game.Entity{minion, first, second}
+
+		g.AddCostModifier(player, game.CostModifier{Amount: -2, Applies: spellsOnly})
+		if cost := g.EffectiveCost(minion); cost != 3 {
+			t.Errorf("Expected the minion to cost 3, got %d", cost)
+		}
+		if cost := g.EffectiveCost(first); cost != 1 {
+			t.Errorf("Expected the spell to cost 1, got %d", cost)
+		}
+
+		// Playing a minion keeps the reduction
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+		if player.Mana != 7 {
+			t.Errorf("Expected 7 mana after the minion, got %d", player.Mana)
+		}
+
+		// The first spell uses it up
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play spell: %v", err)
+		}
+		if player.Mana != 6 {
+			t.Errorf("Expected 6 mana after the reduced spell, got %d", player.Mana)
+		}
+		if cost := g.EffectiveCost(second); cost != 3 {
+			t.Errorf("Expected the next spell to cost 3 again, got %d", cost)
+		}
+	})
+
+	t.Run("Cost is at least 0", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		player.Mana = 0
+
+		spell := game.CreateTestSpellEntity(g, player, game.WithCost(1))
+		player.Hand = []*game.Entity{spell}
+		g.AddBuff(spell, game.Buff{Cost: -3})
+		g.AddCostModifier(player, game.CostModifier{Amount: -2})
+
+		if cost := g.EffectiveCost(spell); cost != 0 {
+			t.Errorf("Expected cost 0, got %d", cost)
+		}
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play free spell: %v", err)
+		}
+		if player.Mana != 0 {
+			t.Errorf("Expected mana to stay 0, got %d", player.Mana)
+		}
+	})
+
+	t.Run("Reduction this turn expires at the end of the turn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		spell := game.CreateTestSpellEntity(g, player, game.WithCost(3))
+		g.AddEntityToHand(player, spell, -1)
+		g.AddCostModifier(player, game.CostModifier{Amount: -1, Duration: game.BuffThisTurn})
+		g.AddCostModifier(player, game.CostModifier{Amount: -1})
+
+		if cost := g.EffectiveCost(spell); cost != 1 {
+			t.Errorf("Expected cost 1 this turn, got %d", cost)
+		}
+		if err := e.EndPlayerTurn(); err != nil {
+			t.Fatalf("Failed to end turn: %v", err)
+		}
+		if cost := g.EffectiveCost(spell); cost != 2 {
+			t.Errorf("Expected cost 2 after the turn, got %d", cost)
+		}
+	})
+
+	t.Run("Legal actions use the effective cost", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		player.Mana = 2
+
+		spell := game.CreateTestSpellEntity(g, player, game.WithCost(4))
+		player.Hand = []*game.Entity{spell}
+		if len(playTargetsOf(g, player)) != 0 {
+			t.Errorf("Expected the spell not to be playable for 4 mana")
+		}
+
+		g.AddCostModifier(player, game.CostModifier{Amount: -2, Applies: spellsOnly})
+		if len(playTargetsOf(g, player)) != 1 {
+			t.Errorf("Expected the reduced spell to be playable")
+		}
+	})
+
+	t.Run("Cards that cost health are paid with the hero", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		player.Mana = 0
+		player.Hero.Armor = 5
+
+		spell := game.CreateTestSpellEntity(g, player,
+			game.WithCost(4),
+			game.WithTag(game.TAG_COSTS_HEALTH, true))
+		player.Hand = []*game.Entity{spell}
+		g.AddCostModifier(player, game.CostModifier{Amount: -1})
+		initialHealth := player.Hero.Health
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play spell that costs health: %v", err)
+		}
+		if player.Hero.Health != initialHealth-3 {
+			t.Errorf("Expected hero health %d, got %d", initialHealth-3, player.Hero.Health)
+		}
+		if player.Hero.Armor != 5 {
+			t.Errorf("Expected armor to stay 5, got %d", player.Hero.Armor)
+		}
+		if player.Mana != 0 {
+			t.Errorf("Expected mana to stay 0, got %d", player.Mana)
+		}
+	})
+
+	t.Run("Hero power uses the effective cost", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		player.Mana = 1
+
+		heroPower := game.NewEntity(&game.Card{
+			Name: "Cost Hero Power",
+			Cost: 2,
+			Type: game.HeroPower,
+		}, g, player)
+		player.HeroPower = heroPower
+
+		// A "next card" reduction doesn't apply to the hero power
+		g.AddCostModifier(player, game.CostModifier{Amount: -1})
+		if cost := g.EffectiveCost(heroPower); cost != 2 {
+			t.Errorf("Expected the hero power to cost 2, got %d", cost)
+		}
+		if err := g.TestUseHeroPower(player, nil); err == nil {
+			t.Errorf("Expected the hero power not to be usable for 1 mana")
+		}
+
+		g.AddBuff(heroPower, game.Buff{Cost: -1})
+		if err := g.UseHeroPower(player, nil); err != nil {
+			t.Fatalf("Failed to use the reduced hero power: %v", err)
+		}
+		if player.Mana != 0 {
+			t.Errorf("Expected 0 mana after the hero power, got %d", player.Mana)
+		}
+		if len(player.CostModifiers) != 1 {
+			t.Errorf("Expected the card reduction to stay unused, got %d modifiers", len(player.CostModifiers))
+		}
+	})
+}
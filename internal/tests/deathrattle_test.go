@@ -0,0 +1,188 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestDeathrattle(t *testing.T) {
+	t.Run("Deathrattle runs after the minion leaves the board", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		var zoneDuringDeathrattle game.Zone
+		fieldSizeDuringDeathrattle := -1
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Deathrattle Minion"),
+			game.WithPower(game.PowerTypeDeathrattle, func(g *game.Game, source, target *game.Entity) {
+				zoneDuringDeathrattle = source.CurrentZone
+				fieldSizeDuringDeathrattle = len(source.Owner.Field)
+			}))
+		g.AddEntityToField(player1, minion, 0)
+
+		g.DealDamage(nil, minion, 10)
+		g.ProcessGraveyard()
+
+		if zoneDuringDeathrattle != game.ZONE_GRAVEYARD {
+			t.Errorf("Expected minion to be in the graveyard during its deathrattle, got %s", zoneDuringDeathrattle)
+		}
+		if fieldSizeDuringDeathrattle != 0 {
+			t.Errorf("Expected field to be empty during deathrattle, got %d minions", fieldSizeDuringDeathrattle)
+		}
+	})
+
+	t.Run("Deathrattle summons appear where the minion died", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		left := game.CreateTestMinionEntity(g, player1, game.WithName("Left"))
+		right := game.CreateTestMinionEntity(g, player1, game.WithName("Right"))
+		dying := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Dying"),
+			game.WithAttack(1),
+			game.WithHealth(1),
+			game.WithPower(game.PowerTypeDeathrattle, func(g *game.Game, source, target *game.Entity) {
+				token := game.CreateTestMinionEntity(g, source.Owner, game.WithName("Token"))
+				g.AddEntityToField(source.Owner, token, source.LastFieldPosition)
+			}))
+		g.AddEntityToField(player1, left, -1)
+		g.AddEntityToField(player1, dying, -1)
+		g.AddEntityToField(player1, right, -1)
+
+		attacker := game.CreateTestMinionEntity(g, player2, game.WithName("Attacker"), game.WithAttack(5), game.WithHealth(5))
+		g.AddEntityToField(player2, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, dying, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if len(player1.Field) != 3 {
+			t.Fatalf("Expected 3 minions on the field, got %d", len(player1.Field))
+		}
+		if player1.Field[0] != left || player1.Field[1].Card.Name != "Token" || player1.Field[2] != right {
+			t.Errorf("Expected field to be [Left, Token, Right], got [%s, %s, %s]",
+				player1.Field[0].Card.Name, player1.Field[1].Card.Name, player1.Field[2].Card.Name)
+		}
+	})
+
+	t.Run("Simultaneous deathrattles resolve in order of play", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		order := []string{}
+		record := func(g *game.Game, source, target *game.Entity) {
+			order = append(order, source.Card.Name)
+		}
+
+		// Played first but standing on the right
+		first := game.CreateTestMinionEntity(g, player2, game.WithName("First"),
+			game.WithPower(game.PowerTypeDeathrattle, record))
+		g.AddEntityToField(player2, first, -1)
+		// Friendly minion played second
+		second := game.CreateTestMinionEntity(g, player1, game.WithName("Second"),
+			game.WithPower(game.PowerTypeDeathrattle, record))
+		g.AddEntityToField(player1, second, -1)
+		// Played last but standing on the left
+		third := game.CreateTestMinionEntity(g, player2, game.WithName("Third"),
+			game.WithPower(game.PowerTypeDeathrattle, record))
+		g.AddEntityToField(player2, third, 0)
+
+		first.IsDestroyed = true
+		second.IsDestroyed = true
+		third.IsDestroyed = true
+		g.ProcessGraveyard()
+
+		expected := []string{"First", "Second", "Third"}
+		if len(order) != len(expected) {
+			t.Fatalf("Expected %d deathrattles, got %d", len(expected), len(order))
+		}
+		for i := range expected {
+			if order[i] != expected[i] {
+				t.Errorf("Expected deathrattle %d to be %s, got %s", i+1, expected[i], order[i])
+			}
+		}
+	})
+
+	t.Run("Weapon deathrattle runs when the weapon is destroyed", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player1.Mana = 10
+
+		count := 0
+		weapon := game.CreateTestWeaponEntity(g, player1,
+			game.WithName("Deathrattle Weapon"),
+			game.WithHealth(1),
+			game.WithPower(game.PowerTypeDeathrattle, func(g *game.Game, source, target *game.Entity) {
+				count++
+			}))
+		g.AddEntityToHand(player1, weapon, -1)
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play weapon: %v", err)
+		}
+
+		// Break the weapon
+		g.DecreaseWeaponDurability(player1)
+		g.ProcessGraveyard()
+
+		if player1.Weapon != nil {
+			t.Errorf("Expected weapon to be destroyed")
+		}
+		if weapon.CurrentZone != game.ZONE_GRAVEYARD {
+			t.Errorf("Expected weapon to be in the graveyard, got %s", weapon.CurrentZone)
+		}
+		if count != 1 {
+			t.Errorf("Expected weapon deathrattle to run once, ran %d times", count)
+		}
+	})
+
+	t.Run("Weapon deathrattle runs when the weapon is replaced", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player1.Mana = 10
+
+		count := 0
+		weapon := game.CreateTestWeaponEntity(g, player1,
+			game.WithName("Deathrattle Weapon"),
+			game.WithPower(game.PowerTypeDeathrattle, func(g *game.Game, source, target *game.Entity) {
+				count++
+			}))
+		g.AddEntityToHand(player1, weapon, -1)
+		g.AddEntityToHand(player1, game.CreateTestWeaponEntity(g, player1), -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-2, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play weapon: %v", err)
+		}
+		if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play replacement weapon: %v", err)
+		}
+
+		if count != 1 {
+			t.Errorf("Expected replaced weapon deathrattle to run once, ran %d times", count)
+		}
+	})
+}
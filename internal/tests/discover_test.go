@@ -0,0 +1,342 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+const discoverPrefix = "Discover Test "
+
+func init() {
+	for _, name := range []string{"Alpha", "Beta", "Gamma", "Delta", "Epsilon"} {
+		game.GetCardManager().RegisterCard(game.Card{
+			Name: discoverPrefix + name,
+			Type: game.Spell,
+			Cost: 1,
+		})
+	}
+}
+
+// discoverTestCards filters the discover pool to the cards registered by this test
+func discoverTestCards(card *game.Card) bool {
+	return strings.HasPrefix(card.Name, discoverPrefix)
+}
+
+// withDiscover gives a test card a power that discovers a test card
+func withDiscover(powerType game.PowerType) func(*game.Card) {
+	return game.WithPower(powerType, func(g *game.Game, source, target *game.Entity) {
+		g.Discover(source.Owner, source, discoverTestCards, nil)
+	})
+}
+
+func optionNames(choice *game.PendingChoice) []string {
+	names := make([]string, len(choice.Options))
+	for i, option := range choice.Options {
+		names[i] = option.Card.Name
+	}
+	return names
+}
+
+func TestDiscover(t *testing.T) {
+	t.Run("Offers three distinct cards from the pool", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		if !g.Discover(player, nil, discoverTestCards, nil) {
+			t.Fatalf("Expected discover to be offered")
+		}
+
+		choice := player.PendingChoice
+		if choice == nil || choice.Type != game.ChoiceDiscover {
+			t.Fatalf("Expected a pending discover choice")
+		}
+		if len(choice.Options) != game.DiscoverOptions {
+			t.Fatalf("Expected %d options, got %d", game.DiscoverOptions, len(choice.Options))
+		}
+
+		seen := make(map[string]bool)
+		for _, name := range optionNames(choice) {
+			if !strings.HasPrefix(name, discoverPrefix) {
+				t.Errorf("Expected option from the filtered pool, got %s", name)
+			}
+			if seen[name] {
+				t.Errorf("Expected distinct options, got %s twice", name)
+			}
+			seen[name] = true
+		}
+	})
+
+	t.Run("Same seed offers the same cards", func(t *testing.T) {
+		offer := func() []string {
+			g := game.CreateTestGame()
+			e := engine.NewEngine(g)
+			e.StartGame()
+			g.SetSeed(42)
+			g.Discover(g.CurrentPlayer, nil, discoverTestCards, nil)
+			return optionNames(g.CurrentPlayer.PendingChoice)
+		}
+
+		first := offer()
+		second := offer()
+		for i := range first {
+			if first[i] != second[i] {
+				t.Fatalf("Expected the same options for the same seed, got %v and %v", first, second)
+			}
+		}
+	})
+
+	t.Run("Empty pool offers nothing", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		if g.Discover(player, nil, func(card *game.Card) bool { return false }, nil) {
+			t.Errorf("Expected discover from an empty pool to fail")
+		}
+		if g.HasPendingChoice() {
+			t.Errorf("Expected no pending choice")
+		}
+	})
+
+	t.Run("Spell resolution waits for the choice", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		spell := game.CreateTestSpellEntity(g, player,
+			game.WithName("Discover Spell"),
+			game.WithCost(0),
+			withDiscover(game.PowerTypeSpell))
+		player.Hand = []*game.Entity{spell}
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play spell: %v", err)
+		}
+		if len(player.Hand) != 0 {
+			t.Fatalf("Expected no card in hand before choosing, got %d", len(player.Hand))
+		}
+
+		// Only the choice can be made while it is pending
+		if len(g.LegalActions(g.Players[1-g.CurrentPlayerIndex])) != 0 {
+			t.Errorf("Expected no legal actions for the opponent")
+		}
+		actions := g.LegalActions(player)
+		if len(actions) != game.DiscoverOptions {
+			t.Fatalf("Expected %d legal actions, got %d", game.DiscoverOptions, len(actions))
+		}
+		for _, action := range actions {
+			if action.Type != game.ActionChoose || action.Player != player {
+				t.Errorf("Expected only choose actions for the player, got %s", action.Type)
+			}
+		}
+		if err := e.EndPlayerTurn(); err == nil {
+			t.Errorf("Expected ending the turn to fail while a choice is pending")
+		}
+		if err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player}); err == nil {
+			t.Errorf("Expected other actions to fail while a choice is pending")
+		}
+
+		chosen := player.PendingChoice.Options[1]
+		if err := e.PerformPlayerAction(game.Action{Type: game.ActionChoose, Player: player, Choice: 1}); err != nil {
+			t.Fatalf("Failed to choose: %v", err)
+		}
+
+		if g.HasPendingChoice() {
+			t.Errorf("Expected no pending choice after choosing")
+		}
+		if len(player.Hand) != 1 || player.Hand[0] != chosen {
+			t.Fatalf("Expected the chosen card in hand")
+		}
+		if chosen.CurrentZone != game.ZONE_HAND {
+			t.Errorf("Expected the chosen card in the hand zone, got %s", chosen.CurrentZone)
+		}
+	})
+
+	t.Run("Later spell powers wait for the choice", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		spell := game.CreateTestSpellEntity(g, player,
+			game.WithName("Discover Then Damage"),
+			game.WithCost(0),
+			withDiscover(game.PowerTypeSpell),
+			game.WithPower(game.PowerTypeSpell, func(g *game.Game, source, target *game.Entity) {
+				g.DealDamage(source, opponent.Hero, 3)
+			}))
+		player.Hand = []*game.Entity{spell}
+		initialHealth := opponent.Hero.Health
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play spell: %v", err)
+		}
+		if opponent.Hero.Health != initialHealth {
+			t.Errorf("Expected no damage before choosing, got health %d", opponent.Hero.Health)
+		}
+		if spell.CurrentZone == game.ZONE_GRAVEYARD {
+			t.Errorf("Expected the spell not to reach the graveyard before choosing")
+		}
+
+		if err := e.Choose(player, 0); err != nil {
+			t.Fatalf("Failed to choose: %v", err)
+		}
+		if opponent.Hero.Health != initialHealth-3 {
+			t.Errorf("Expected health %d after choosing, got %d", initialHealth-3, opponent.Hero.Health)
+		}
+		if spell.CurrentZone != game.ZONE_GRAVEYARD {
+			t.Errorf("Expected the spell in the graveyard after choosing, got %s", spell.CurrentZone)
+		}
+	})
+
+	t.Run("Minion played triggers wait for the choice", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Discover Minion"),
+			game.WithCost(0),
+			withDiscover(game.PowerTypeBattlecry))
+		player.Hand = []*game.Entity{minion}
+
+		played := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerMinionPlayed, nil, func(ctx *game.TriggerContext, self *game.Entity) {
+			if ctx.SourceEntity == minion {
+				played++
+			}
+		}, false)
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+		if played != 0 {
+			t.Errorf("Expected no minion played trigger before choosing")
+		}
+
+		if err := e.Choose(player, 0); err != nil {
+			t.Fatalf("Failed to choose: %v", err)
+		}
+		if played != 1 {
+			t.Errorf("Expected 1 minion played trigger after choosing, got %d", played)
+		}
+	})
+
+	t.Run("Invalid choices are rejected", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		if err := e.Choose(player, 0); err == nil {
+			t.Errorf("Expected choosing without a pending choice to fail")
+		}
+
+		g.Discover(player, nil, discoverTestCards, nil)
+		if err := e.Choose(player, game.DiscoverOptions); err == nil {
+			t.Errorf("Expected an out of range choice to fail")
+		}
+		if err := e.Choose(opponent, 0); err == nil {
+			t.Errorf("Expected the opponent's choice to fail")
+		}
+		if !g.HasPendingChoice() {
+			t.Errorf("Expected the choice to still be pending")
+		}
+	})
+
+	t.Run("Battlecry continues with the chosen card", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		var minion *game.Entity
+		minion = game.CreateTestMinionEntity(g, player,
+			game.WithName("Discover Minion"),
+			game.WithCost(0),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {
+				g.Discover(source.Owner, source, discoverTestCards, func(g *game.Game, chosen *game.Entity) {
+					g.AddBuff(minion, game.Buff{Source: source, Attack: chosen.Cost})
+					g.AddEntityToHand(source.Owner, chosen, -1)
+				})
+			}))
+		player.Hand = []*game.Entity{minion}
+		initialAttack := minion.Attack
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+		if len(player.Field) != 1 {
+			t.Fatalf("Expected minion on the field, got %d minions", len(player.Field))
+		}
+		if minion.Attack != initialAttack {
+			t.Fatalf("Expected battlecry to wait for the choice")
+		}
+
+		if err := e.Choose(player, 0); err != nil {
+			t.Fatalf("Failed to choose: %v", err)
+		}
+		if minion.Attack != initialAttack+1 {
+			t.Errorf("Expected attack %d after choosing, got %d", initialAttack+1, minion.Attack)
+		}
+		if len(player.Hand) != 1 {
+			t.Errorf("Expected the chosen card in hand, got %d cards", len(player.Hand))
+		}
+	})
+
+	t.Run("Battlecry twice queues a second choice", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		game.SetTag(&player.Hero.Tags, game.TAG_BATTLECRY_TWICE, true)
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Discover Minion"),
+			game.WithCost(0),
+			withDiscover(game.PowerTypeBattlecry))
+		player.Hand = []*game.Entity{minion}
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if err := e.Choose(player, 0); err != nil {
+			t.Fatalf("Failed to make the first choice: %v", err)
+		}
+		if !g.HasPendingChoice() {
+			t.Fatalf("Expected the second choice to be offered")
+		}
+		if err := e.Choose(player, 0); err != nil {
+			t.Fatalf("Failed to make the second choice: %v", err)
+		}
+
+		if g.HasPendingChoice() {
+			t.Errorf("Expected no pending choice after both choices")
+		}
+		if len(player.Hand) != 2 {
+			t.Errorf("Expected 2 discovered cards in hand, got %d", len(player.Hand))
+		}
+		if err := e.EndPlayerTurn(); err != nil {
+			t.Errorf("Expected the turn to end after choosing: %v", err)
+		}
+	})
+}
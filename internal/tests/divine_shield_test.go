@@ -0,0 +1,146 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestDivineShield(t *testing.T) {
+	t.Run("Divine shield absorbs damage", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player2 := g.Players[1]
+
+		shielded := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Shielded Minion"),
+			game.WithAttack(2),
+			game.WithHealth(2),
+			game.WithTag(game.TAG_DIVINE_SHIELD, true))
+		g.AddEntityToField(player2, shielded, 0)
+
+		// First damage instance only removes the shield
+		dealt := g.DealDamage(nil, shielded, 5)
+		if dealt != 0 {
+			t.Errorf("Expected no damage to be dealt, got %d", dealt)
+		}
+		if shielded.Health != 2 {
+			t.Errorf("Expected health to remain 2, got %d", shielded.Health)
+		}
+		if game.HasTag(shielded.Tags, game.TAG_DIVINE_SHIELD) {
+			t.Errorf("Expected divine shield to be removed")
+		}
+
+		// Second damage instance hits
+		g.DealDamage(nil, shielded, 1)
+		if shielded.Health != 1 {
+			t.Errorf("Expected health to be 1 after the shield is gone, got %d", shielded.Health)
+		}
+	})
+
+	t.Run("Poisonous does not destroy a shielded minion", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Poisonous Attacker"),
+			game.WithAttack(1),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_POISONOUS, true))
+		shielded := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Shielded Minion"),
+			game.WithAttack(1),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_DIVINE_SHIELD, true))
+		g.AddEntityToField(player1, attacker, 0)
+		g.AddEntityToField(player2, shielded, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, shielded, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if shielded.IsDestroyed || shielded.CurrentZone != game.ZONE_PLAY {
+			t.Errorf("Expected shielded minion to survive the poisonous attack")
+		}
+		if shielded.Health != 5 {
+			t.Errorf("Expected shielded minion health to remain 5, got %d", shielded.Health)
+		}
+	})
+
+	t.Run("Lifesteal heals nothing against divine shield", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Hero.Health = 20
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Lifesteal Attacker"),
+			game.WithAttack(3),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_LIFESTEAL, true))
+		shielded := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Shielded Minion"),
+			game.WithAttack(0),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_DIVINE_SHIELD, true))
+		g.AddEntityToField(player1, attacker, 0)
+		g.AddEntityToField(player2, shielded, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, shielded, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if player1.Hero.Health != 20 {
+			t.Errorf("Expected hero health to remain 20, got %d", player1.Hero.Health)
+		}
+	})
+
+	t.Run("Shield loss fires its own trigger instead of damage taken", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player2 := g.Players[1]
+
+		shielded := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Shielded Minion"),
+			game.WithTag(game.TAG_DIVINE_SHIELD, true))
+		g.AddEntityToField(player2, shielded, 0)
+
+		damageTaken := 0
+		shieldLost := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerDamageTaken, shielded, func(ctx *game.TriggerContext, self *game.Entity) {
+			damageTaken++
+		}, false)
+		g.TriggerManager.RegisterTrigger(game.TriggerDivineShieldLost, shielded, func(ctx *game.TriggerContext, self *game.Entity) {
+			if ctx.TargetEntity == self {
+				shieldLost++
+			}
+		}, false)
+
+		g.DealDamage(nil, shielded, 2)
+
+		if damageTaken != 0 {
+			t.Errorf("Expected damage taken trigger not to fire, fired %d times", damageTaken)
+		}
+		if shieldLost != 1 {
+			t.Errorf("Expected divine shield lost trigger to fire once, fired %d times", shieldLost)
+		}
+	})
+}
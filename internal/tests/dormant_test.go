@@ -0,0 +1,196 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestDormant(t *testing.T) {
+	t.Run("Dormant minion cannot attack, be attacked or be targeted", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+
+		dormant := game.CreateTestMinionEntity(g, player1,
+			game.WithAttack(3), game.WithTag(game.TAG_DORMANT, 2), game.WithTag(game.TAG_TAUNT, true))
+		g.AddEntityToField(player1, dormant, 0)
+		dormant.Exhausted = false
+
+		if err := g.Attack(dormant, player2.Hero, false); err == nil {
+			t.Errorf("Expected dormant minion to be unable to attack")
+		}
+
+		attacker := game.CreateTestMinionEntity(g, player2, game.WithAttack(2))
+		g.AddEntityToField(player2, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.CanAttack(attacker, dormant); err == nil {
+			t.Errorf("Expected dormant minion to be unable to be attacked")
+		}
+		// A dormant taunt does not protect the hero
+		if err := g.CanAttack(attacker, player1.Hero); err != nil {
+			t.Errorf("Expected dormant taunt to be ignored, but got error: %v", err)
+		}
+
+		spell := game.CreateTestSpellEntity(g, player1)
+		if err := g.CanBeTargetedBy(spell, dormant); err == nil {
+			t.Errorf("Expected dormant minion to be untargetable")
+		}
+	})
+
+	t.Run("Dormant minion triggers are off", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		dormant := game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_DORMANT, 1))
+		g.AddEntityToField(player1, dormant, 0)
+
+		fired := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerMinionSummoned, dormant, func(ctx *game.TriggerContext, self *game.Entity) {
+			fired++
+		}, false)
+
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1), -1)
+		if fired != 0 {
+			t.Errorf("Expected dormant minion trigger not to fire, fired %d times", fired)
+		}
+
+		g.Awaken(dormant)
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1), -1)
+		if fired != 1 {
+			t.Errorf("Expected awakened minion trigger to fire once, fired %d times", fired)
+		}
+	})
+
+	t.Run("Dormant minion awakens after its countdown", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		dormant := game.CreateTestMinionEntity(g, player1, game.WithAttack(3), game.WithTag(game.TAG_DORMANT, 2))
+		g.AddEntityToField(player1, dormant, 0)
+
+		awakened := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerMinionAwaken, dormant, func(ctx *game.TriggerContext, self *game.Entity) {
+			if ctx.SourceEntity == self {
+				awakened++
+			}
+		}, false)
+
+		endTurn := func(player *game.Player) {
+			if err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player}); err != nil {
+				t.Fatalf("Failed to end turn: %v", err)
+			}
+		}
+
+		// Opponent's turn does not count down
+		endTurn(player1)
+		if got := game.GetTagInt(dormant.Tags, game.TAG_DORMANT); got != 2 {
+			t.Errorf("Expected 2 dormant turns left after the opponent's turn start, got %d", got)
+		}
+
+		endTurn(player2)
+		if got := game.GetTagInt(dormant.Tags, game.TAG_DORMANT); got != 1 {
+			t.Errorf("Expected 1 dormant turn left, got %d", got)
+		}
+
+		endTurn(player1)
+		endTurn(player2)
+		if game.IsDormant(dormant) {
+			t.Fatalf("Expected minion to awaken at the start of its owner's turn")
+		}
+		if awakened != 1 {
+			t.Errorf("Expected awaken trigger to fire once, fired %d times", awakened)
+		}
+		if err := g.CanAttack(dormant, player2.Hero); err != nil {
+			t.Errorf("Expected awakened minion to be able to attack, but got error: %v", err)
+		}
+	})
+
+	t.Run("Dormant minion without a countdown stays dormant", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		dormant := game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_DORMANT, true))
+		g.AddEntityToField(player1, dormant, 0)
+
+		for i := 0; i < 2; i++ {
+			for _, player := range []*game.Player{player1, player2} {
+				if err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player}); err != nil {
+					t.Fatalf("Failed to end turn: %v", err)
+				}
+			}
+		}
+		if !game.IsDormant(dormant) {
+			t.Fatalf("Expected minion without a countdown to stay dormant")
+		}
+
+		// An effect can still awaken it
+		g.Awaken(dormant)
+		if game.IsDormant(dormant) {
+			t.Errorf("Expected minion to awaken when an effect awakens it")
+		}
+	})
+
+	t.Run("Dormant minion modifiers are off", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		dormant := game.CreateTestMinionEntity(g, player1,
+			game.WithTag(game.TAG_DORMANT, 2),
+			game.WithTag(game.TAG_SPELLPOWER, 1),
+			game.WithTag(game.TAG_SPELLPOWER_DOUBLE, true),
+			game.WithTag(game.TAG_BATTLECRY_TWICE, true),
+			game.WithTag(game.TAG_CHOOSE_BOTH, true))
+		g.AddEntityToField(player1, dormant, 0)
+
+		if got := g.SpellDamage(player1); got != 0 {
+			t.Errorf("Expected no Spell Damage from a dormant minion, got %d", got)
+		}
+		if got := g.CalculateSpellDamage(player1, 3); got != 3 {
+			t.Errorf("Expected a 3 damage spell to deal 3 with a dormant spellpower minion, got %d", got)
+		}
+		if got := g.BattlecryCount(player1); got != 1 {
+			t.Errorf("Expected battlecries to trigger once, got %d", got)
+		}
+		if g.ChooseBoth(player1) {
+			t.Errorf("Expected Choose One not to be combined by a dormant minion")
+		}
+
+		// The modifiers apply once the minion awakens
+		g.Awaken(dormant)
+		if got := g.CalculateSpellDamage(player1, 3); got != 8 {
+			t.Errorf("Expected a 3 damage spell to deal 8 after awakening, got %d", got)
+		}
+		if got := g.BattlecryCount(player1); got != 2 {
+			t.Errorf("Expected battlecries to trigger twice after awakening, got %d", got)
+		}
+		if !g.ChooseBoth(player1) {
+			t.Errorf("Expected Choose One to be combined after awakening")
+		}
+	})
+}
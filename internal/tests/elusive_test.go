@@ -0,0 +1,94 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestElusive(t *testing.T) {
+	t.Run("Spells cannot target Elusive characters", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+
+		elusive := game.CreateTestMinionEntity(g, player2, game.WithTag(game.TAG_CANT_BE_TARGETED, true))
+		g.AddEntityToField(player2, elusive, 0)
+
+		spell := game.CreateTestSpellEntity(g, player1,
+			game.WithRequirement(game.REQ_TARGET_TO_PLAY, 0))
+		g.AddEntityToHand(player1, spell, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, elusive, -1, 0); err == nil {
+			t.Errorf("Expected spell to fail targeting an Elusive minion")
+		}
+
+		for _, target := range g.ValidPlayTargets(player1, spell) {
+			if target == elusive {
+				t.Errorf("Expected Elusive minion not to be a valid spell target")
+			}
+		}
+
+		// Also covers the caster's own Elusive characters
+		player1.Hero.Tags = append(player1.Hero.Tags, game.NewTag(game.TAG_CANT_BE_TARGETED, true))
+		if err := g.CanBeTargetedBy(spell, player1.Hero); err == nil {
+			t.Errorf("Expected spell to fail targeting a friendly Elusive hero")
+		}
+	})
+
+	t.Run("Battlecries can target Elusive characters", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+
+		elusive := game.CreateTestMinionEntity(g, player2, game.WithHealth(5), game.WithTag(game.TAG_CANT_BE_TARGETED, true))
+		g.AddEntityToField(player2, elusive, 0)
+
+		var battlecryTarget *game.Entity
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithRequirement(game.REQ_TARGET_IF_AVAILABLE, 0),
+			game.WithPower(game.PowerTypeBattlecry, func(g *game.Game, source, target *game.Entity) {
+				battlecryTarget = target
+			}))
+		g.AddEntityToHand(player1, minion, -1)
+
+		if err := g.PlayCard(player1, len(player1.Hand)-1, elusive, -1, 0); err != nil {
+			t.Fatalf("Expected battlecry to target an Elusive minion, but got error: %v", err)
+		}
+		if battlecryTarget != elusive {
+			t.Errorf("Expected battlecry to hit the Elusive minion")
+		}
+	})
+
+	t.Run("Elusive characters can still be attacked", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1, game.WithAttack(2))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		elusive := game.CreateTestMinionEntity(g, player2, game.WithHealth(5), game.WithTag(game.TAG_CANT_BE_TARGETED, true))
+		g.AddEntityToField(player2, elusive, 0)
+
+		if err := g.Attack(attacker, elusive, false); err != nil {
+			t.Errorf("Expected attack on an Elusive minion to succeed, but got error: %v", err)
+		}
+	})
+}
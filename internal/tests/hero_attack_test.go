@@ -0,0 +1,135 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestHeroAttack(t *testing.T) {
+	t.Run("Hero attack includes the equipped weapon", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+		opponent := g.Players[1]
+
+		player.Weapon = game.CreateTestWeaponEntity(g, player,
+			game.WithName("Test Weapon"),
+			game.WithAttack(3),
+			game.WithHealth(2))
+		player.Hero.Exhausted = false
+
+		if attack := g.EffectiveAttack(player.Hero); attack != 3 {
+			t.Errorf("Expected hero attack 3, got %d", attack)
+		}
+
+		initialHealth := opponent.Hero.Health
+		if err := e.Attack(player.Hero, opponent.Hero, false); err != nil {
+			t.Fatalf("Expected hero attack to succeed, got error: %v", err)
+		}
+		if opponent.Hero.Health != initialHealth-3 {
+			t.Errorf("Expected opponent hero health %d, got %d", initialHealth-3, opponent.Hero.Health)
+		}
+		if player.Weapon == nil || player.Weapon.Health != 1 {
+			t.Errorf("Expected weapon to lose one durability")
+		}
+	})
+
+	t.Run("Attack gained this turn expires at end of turn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		player.Weapon = game.CreateTestWeaponEntity(g, player,
+			game.WithName("Test Weapon"),
+			game.WithAttack(2),
+			game.WithHealth(2))
+		g.AddBuff(player.Hero, game.Buff{Attack: 2, Duration: game.BuffThisTurn})
+
+		if attack := g.EffectiveAttack(player.Hero); attack != 4 {
+			t.Errorf("Expected hero attack 4, got %d", attack)
+		}
+
+		e.EndPlayerTurn()
+
+		if len(player.Hero.Buffs) != 0 {
+			t.Errorf("Expected temporary attack to expire, got %d buffs", len(player.Hero.Buffs))
+		}
+		if attack := g.EffectiveAttack(player.Hero); attack != 2 {
+			t.Errorf("Expected hero attack 2 after end of turn, got %d", attack)
+		}
+	})
+
+	t.Run("Attacked hero does not strike back", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.Players[0]
+		opponent := g.Players[1]
+
+		// Give the defending hero a weapon
+		opponent.Weapon = game.CreateTestWeaponEntity(g, opponent,
+			game.WithName("Test Weapon"),
+			game.WithAttack(4),
+			game.WithHealth(2))
+
+		attacker := game.CreateTestMinionEntity(g, player,
+			game.WithName("Attacker"),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		e.AddEntityToField(player, attacker, -1)
+		attacker.Exhausted = false
+
+		if err := e.Attack(attacker, opponent.Hero, false); err != nil {
+			t.Fatalf("Expected attack to succeed, got error: %v", err)
+		}
+		if attacker.Health != 3 {
+			t.Errorf("Expected attacker to take no damage, got health %d", attacker.Health)
+		}
+		if opponent.Weapon.Health != 2 {
+			t.Errorf("Expected defending weapon to keep its durability, got %d", opponent.Weapon.Health)
+		}
+	})
+
+	t.Run("Hero attack is a legal action", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		player.Hero.Exhausted = false
+		for _, action := range e.LegalActions(player) {
+			if action.Type == game.ActionAttack && action.Source == player.Hero {
+				t.Fatalf("Expected no hero attack without attack")
+			}
+		}
+
+		g.AddBuff(player.Hero, game.Buff{Attack: 1, Duration: game.BuffThisTurn})
+		var heroAttack *game.Action
+		for _, action := range e.LegalActions(player) {
+			if action.Type == game.ActionAttack && action.Source == player.Hero && action.Target == opponent.Hero {
+				heroAttack = &action
+				break
+			}
+		}
+		if heroAttack == nil {
+			t.Fatalf("Expected hero attack on the enemy hero to be legal")
+		}
+
+		initialHealth := opponent.Hero.Health
+		if err := e.PerformPlayerAction(*heroAttack); err != nil {
+			t.Fatalf("Expected hero attack action to succeed, got error: %v", err)
+		}
+		if opponent.Hero.Health != initialHealth-1 {
+			t.Errorf("Expected opponent hero health %d, got %d", initialHealth-1, opponent.Hero.Health)
+		}
+	})
+}
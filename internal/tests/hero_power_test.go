@@ -0,0 +1,130 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestHeroPower(t *testing.T) {
+	// createHeroPower gives the player a 2 mana hero power that deals 1 damage to a target
+	createHeroPower := func(g *game.Game, player *game.Player) *game.Entity {
+		card := &game.Card{
+			Name: "Test Hero Power",
+			Cost: 2,
+			Type: game.HeroPower,
+			Powers: []game.Power{
+				{
+					Type: game.PowerTypeHeroPower,
+					Action: func(g *game.Game, source, target *game.Entity) {
+						g.DealDamage(source, target, 1)
+					},
+				},
+			},
+			Requirements: map[game.PlayRequirement]int{
+				game.REQ_TARGET_TO_PLAY: 0,
+			},
+		}
+		player.HeroPower = game.NewEntity(card, g, player)
+		return player.HeroPower
+	}
+
+	t.Run("Hero power can be used once per turn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+		createHeroPower(g, player1)
+
+		used := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerHeroPowerUsed, nil, func(ctx *game.TriggerContext, self *game.Entity) {
+			if ctx.SourceEntity == player1.HeroPower && ctx.TargetEntity == player2.Hero {
+				used++
+			}
+		}, false)
+
+		action := game.Action{Type: game.ActionHeroPower, Player: player1, Source: player1.HeroPower, Target: player2.Hero}
+		if err := e.PerformPlayerAction(action); err != nil {
+			t.Fatalf("Expected hero power to succeed, but got error: %v", err)
+		}
+		if player1.Mana != 8 {
+			t.Errorf("Expected mana to be 8 after hero power, got %d", player1.Mana)
+		}
+		if used != 1 {
+			t.Errorf("Expected hero power used trigger to fire once, fired %d times", used)
+		}
+
+		if err := e.PerformPlayerAction(action); err == nil {
+			t.Errorf("Expected hero power to fail the second time in a turn")
+		}
+		for _, legal := range g.LegalActions(player1) {
+			if legal.Type == game.ActionHeroPower {
+				t.Errorf("Expected no legal hero power actions after using it")
+			}
+		}
+
+		// Ready again on the player's next turn
+		e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player1})
+		e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player2})
+		if player1.HeroPower.Exhausted {
+			t.Errorf("Expected hero power to be ready on the next turn")
+		}
+	})
+
+	t.Run("Hero power needs enough mana", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 1
+		createHeroPower(g, player1)
+
+		if err := g.UseHeroPower(player1, player2.Hero); err == nil {
+			t.Errorf("Expected hero power to fail without enough mana")
+		}
+	})
+
+	t.Run("Hero power respects targeting", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+		createHeroPower(g, player1)
+
+		elusive := game.CreateTestMinionEntity(g, player2, game.WithTag(game.TAG_CANT_BE_TARGETED, true))
+		g.AddEntityToField(player2, elusive, 0)
+
+		if err := g.UseHeroPower(player1, nil); err == nil {
+			t.Errorf("Expected hero power to require a target")
+		}
+		if err := g.UseHeroPower(player1, elusive); err == nil {
+			t.Errorf("Expected hero power to fail targeting an Elusive minion")
+		}
+
+		// Legal actions list every valid target
+		targets := 0
+		for _, action := range g.LegalActions(player1) {
+			if action.Type == game.ActionHeroPower {
+				if action.Target == elusive {
+					t.Errorf("Expected Elusive minion not to be a legal hero power target")
+				}
+				targets++
+			}
+		}
+		if targets != 2 {
+			t.Errorf("Expected 2 legal hero power targets (both heroes), got %d", targets)
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestImmune(t *testing.T) {
+	t.Run("Immune minion takes no damage in combat", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithAttack(3), game.WithHealth(2), game.WithTag(game.TAG_IMMUNE, true))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		defender := game.CreateTestMinionEntity(g, player2, game.WithAttack(5), game.WithHealth(5))
+		g.AddEntityToField(player2, defender, 0)
+
+		if err := g.Attack(attacker, defender, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if attacker.Health != 2 {
+			t.Errorf("Expected immune attacker to keep 2 health, got %d", attacker.Health)
+		}
+		if defender.Health != 2 {
+			t.Errorf("Expected defender to take 3 damage, got %d health", defender.Health)
+		}
+	})
+
+	t.Run("Immune hero takes no damage", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		hero := g.Players[1].Hero
+		hero.Tags = append(hero.Tags, game.NewTag(game.TAG_IMMUNE, true))
+		initialHealth := hero.Health
+
+		if dealt := g.DealDamage(nil, hero, 10); dealt != 0 {
+			t.Errorf("Expected no damage dealt to an immune hero, got %d", dealt)
+		}
+		if hero.Health != initialHealth {
+			t.Errorf("Expected hero health to stay at %d, got %d", initialHealth, hero.Health)
+		}
+	})
+
+	t.Run("Immune minion ignores destroy effects", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		immune := game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_IMMUNE, true))
+		normal := game.CreateTestMinionEntity(g, player1)
+		g.AddEntityToField(player1, immune, -1)
+		g.AddEntityToField(player1, normal, -1)
+
+		if g.Destroy(immune) {
+			t.Errorf("Expected destroy effect to be ignored by an immune minion")
+		}
+		if !g.Destroy(normal) {
+			t.Errorf("Expected destroy effect to mark a normal minion")
+		}
+		g.ProcessGraveyard()
+
+		if immune.CurrentZone != game.ZONE_PLAY {
+			t.Errorf("Expected immune minion to stay in play, got %s", immune.CurrentZone)
+		}
+		if normal.CurrentZone != game.ZONE_GRAVEYARD {
+			t.Errorf("Expected normal minion to be destroyed, got %s", normal.CurrentZone)
+		}
+	})
+
+	t.Run("Poisonous does not destroy an immune minion", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithAttack(1), game.WithHealth(5), game.WithTag(game.TAG_POISONOUS, true))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		defender := game.CreateTestMinionEntity(g, player2,
+			game.WithAttack(1), game.WithHealth(5), game.WithTag(game.TAG_IMMUNE, true))
+		g.AddEntityToField(player2, defender, 0)
+
+		if err := g.Attack(attacker, defender, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if defender.CurrentZone != game.ZONE_PLAY {
+			t.Errorf("Expected immune defender to survive poisonous, got %s", defender.CurrentZone)
+		}
+	})
+}
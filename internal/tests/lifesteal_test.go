@@ -115,9 +115,6 @@ func TestWeaponLifesteal(t *testing.T) {
 	// Equip the weapon
 	player1.Weapon = weapon
 
-	// Set the hero's attack to match the weapon
-	player1.Hero.Attack = weapon.Attack
-
 	// Set up target minion
 	target := game.CreateTestMinionEntity(g, player2,
 		game.WithName("Target Minion"),
@@ -162,7 +159,6 @@ func TestWeaponLifesteal(t *testing.T) {
 		game.WithHealth(2)) // Durability
 
 	player1.Weapon = normalWeapon
-	player1.Hero.Attack = normalWeapon.Attack
 
 	// Create a new target
 	target2 := game.CreateTestMinionEntity(g, player2,
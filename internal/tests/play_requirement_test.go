@@ -0,0 +1,185 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+// createRequirementSpell creates a free targeted spell with extra play requirements
+func createRequirementSpell(g *game.Game, player *game.Player, reqs ...game.PlayRequirement) *game.Entity {
+	opts := []func(*game.Card){
+		game.WithName("Requirement Spell"),
+		game.WithCost(0),
+		game.WithRequirement(game.REQ_TARGET_TO_PLAY, 0),
+	}
+	for _, req := range reqs {
+		opts = append(opts, game.WithRequirement(req, 0))
+	}
+	return game.CreateTestSpellEntity(g, player, opts...)
+}
+
+// playTargetsOf returns the targets the legal actions offer for the first card in hand
+func playTargetsOf(g *game.Game, player *game.Player) []*game.Entity {
+	targets := make([]*game.Entity, 0)
+	for _, action := range g.LegalActions(player) {
+		if action.Type == game.ActionPlayCard && action.HandIndex == 0 {
+			targets = append(targets, action.Target)
+		}
+	}
+	return targets
+}
+
+func containsEntity(entities []*game.Entity, entity *game.Entity) bool {
+	for _, e := range entities {
+		if e == entity {
+			return true
+		}
+	}
+	return false
+}
+
+func TestPlayRequirements(t *testing.T) {
+	t.Run("Target requirements", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			req     game.PlayRequirement
+			allowed func(player, opponent *game.Player, friendly, enemy *game.Entity) []*game.Entity
+		}{
+			{"Minion only", game.REQ_MINION_TARGET, func(player, opponent *game.Player, friendly, enemy *game.Entity) []*game.Entity {
+				return []*game.Entity{friendly, enemy}
+			}},
+			{"Enemy only", game.REQ_ENEMY_TARGET, func(player, opponent *game.Player, friendly, enemy *game.Entity) []*game.Entity {
+				return []*game.Entity{opponent.Hero, enemy}
+			}},
+			{"Friendly only", game.REQ_FRIENDLY_TARGET, func(player, opponent *game.Player, friendly, enemy *game.Entity) []*game.Entity {
+				return []*game.Entity{player.Hero, friendly}
+			}},
+			{"Damaged only", game.REQ_DAMAGED_TARGET, func(player, opponent *game.Player, friendly, enemy *game.Entity) []*game.Entity {
+				return []*game.Entity{enemy}
+			}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Setup game
+				g := game.CreateTestGame()
+				e := engine.NewEngine(g)
+				e.StartGame()
+				player := g.CurrentPlayer
+				opponent := g.Players[1-g.CurrentPlayerIndex]
+
+				friendly := game.CreateTestMinionEntity(g, player, game.WithName("Friendly Minion"))
+				enemy := game.CreateTestMinionEntity(g, opponent, game.WithName("Enemy Minion"), game.WithHealth(3))
+				g.AddEntityToField(player, friendly, -1)
+				g.AddEntityToField(opponent, enemy, -1)
+				enemy.Health = 1
+
+				player.Hand = []*game.Entity{createRequirementSpell(g, player, tt.req)}
+				allowed := tt.allowed(player, opponent, friendly, enemy)
+
+				// The legal actions offer exactly the targets PlayCard accepts
+				targets := playTargetsOf(g, player)
+				for _, target := range []*game.Entity{player.Hero, opponent.Hero, friendly, enemy} {
+					valid := containsEntity(allowed, target)
+					if containsEntity(targets, target) != valid {
+						t.Errorf("Expected %s to be a legal target: %v", target.Card.Name, valid)
+					}
+					err := g.TestPlayCard(player, player.Hand[0], target, 0)
+					if (err == nil) != valid {
+						t.Errorf("Expected playing on %s to be allowed: %v, got error %v", target.Card.Name, valid, err)
+					}
+				}
+
+				if err := e.PlayCard(player, 0, nil, -1, 0); err == nil {
+					t.Errorf("Expected playing without a target to fail")
+				}
+			})
+		}
+	})
+
+	t.Run("Cards without targeting reject a target", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		spell := game.CreateTestSpellEntity(g, player, game.WithName("Untargeted Spell"), game.WithCost(0))
+		player.Hand = []*game.Entity{spell}
+
+		targets := playTargetsOf(g, player)
+		if len(targets) != 1 || targets[0] != nil {
+			t.Errorf("Expected only an untargeted play action")
+		}
+		if err := e.PlayCard(player, 0, opponent.Hero, -1, 0); err == nil {
+			t.Errorf("Expected playing with a target to fail")
+		}
+		if len(player.Hand) != 1 {
+			t.Errorf("Expected the spell to stay in hand")
+		}
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Errorf("Expected playing without a target to succeed: %v", err)
+		}
+	})
+
+	t.Run("Minimum minions", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		spell := game.CreateTestSpellEntity(g, player,
+			game.WithName("Minimum Minions Spell"),
+			game.WithCost(0),
+			game.WithRequirement(game.REQ_MINIMUM_ENEMY_MINIONS, 1),
+			game.WithRequirement(game.REQ_MINIMUM_TOTAL_MINIONS, 2))
+		player.Hand = []*game.Entity{spell}
+
+		// Friendly minions don't count as enemy minions
+		g.AddEntityToField(player, game.CreateTestMinionEntity(g, player), -1)
+		g.AddEntityToField(player, game.CreateTestMinionEntity(g, player), -1)
+		if err := g.TestPlayCard(player, spell, nil, 0); err == nil {
+			t.Errorf("Expected playing without enemy minions to fail")
+		}
+		if len(playTargetsOf(g, player)) != 0 {
+			t.Errorf("Expected no legal play actions without enemy minions")
+		}
+
+		g.AddEntityToField(opponent, game.CreateTestMinionEntity(g, opponent), -1)
+		if len(playTargetsOf(g, player)) != 1 {
+			t.Errorf("Expected a legal play action with enough minions")
+		}
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Errorf("Expected playing with enough minions to succeed: %v", err)
+		}
+	})
+
+	t.Run("Target if available respects the requirements", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		// No damaged character, so the battlecry can be played without a target
+		minion := game.CreateTestMinionEntity(g, player,
+			game.WithName("Damaged Battlecry"),
+			game.WithCost(0),
+			game.WithRequirement(game.REQ_TARGET_IF_AVAILABLE, 0),
+			game.WithRequirement(game.REQ_DAMAGED_TARGET, 0))
+		player.Hand = []*game.Entity{minion}
+
+		targets := playTargetsOf(g, player)
+		if len(targets) != 1 || targets[0] != nil {
+			t.Errorf("Expected only an untargeted play action")
+		}
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Errorf("Expected playing without a damaged target to succeed: %v", err)
+		}
+	})
+}
@@ -57,7 +57,7 @@ func TestPoisonous(t *testing.T) {
 		}
 
 		// Check that the defender moves to the graveyard when destroyed
-		// This happens inside the Attack method, no need to call processDestroyAndUpdateAura separately
+		// This happens inside the Attack method, no need to call ProcessDestroyAndUpdateAura separately
 		if len(player1.Field) != 1 {
 			t.Errorf("Expected attacker's field to have 1 minion, got %d minions", len(player1.Field))
 		}
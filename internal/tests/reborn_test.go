@@ -0,0 +1,170 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestReborn(t *testing.T) {
+	t.Run("Reborn minion returns with 1 health and without reborn", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		left := game.CreateTestMinionEntity(g, player1, game.WithName("Left"))
+		reborn := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Reborn Minion"),
+			game.WithAttack(2),
+			game.WithHealth(3),
+			game.WithTag(game.TAG_REBORN, true))
+		right := game.CreateTestMinionEntity(g, player1, game.WithName("Right"))
+		g.AddEntityToField(player1, left, -1)
+		g.AddEntityToField(player1, reborn, -1)
+		g.AddEntityToField(player1, right, -1)
+
+		attacker := game.CreateTestMinionEntity(g, player2, game.WithName("Attacker"), game.WithAttack(5), game.WithHealth(5))
+		g.AddEntityToField(player2, attacker, 0)
+		attacker.Exhausted = false
+
+		summoned := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerMinionSummoned, nil, func(ctx *game.TriggerContext, self *game.Entity) {
+			summoned++
+		}, false)
+
+		if err := g.Attack(attacker, reborn, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if len(player1.Field) != 3 {
+			t.Fatalf("Expected 3 minions on the field, got %d", len(player1.Field))
+		}
+		returned := player1.Field[1]
+		if returned == reborn || returned.Card.Name != "Reborn Minion" {
+			t.Fatalf("Expected a fresh copy of the reborn minion in the middle slot, got %s", returned.Card.Name)
+		}
+		if returned.Health != 1 || returned.MaxHealth != 1 {
+			t.Errorf("Expected reborn minion to have 1/1 health, got %d/%d", returned.Health, returned.MaxHealth)
+		}
+		if returned.Attack != 2 {
+			t.Errorf("Expected reborn minion to keep its attack of 2, got %d", returned.Attack)
+		}
+		if game.HasTag(returned.Tags, game.TAG_REBORN) {
+			t.Errorf("Expected reborn minion to lose reborn")
+		}
+		if summoned != 1 {
+			t.Errorf("Expected 1 minion summoned trigger, got %d", summoned)
+		}
+		if reborn.CurrentZone != game.ZONE_GRAVEYARD {
+			t.Errorf("Expected original minion to stay in the graveyard, got %s", reborn.CurrentZone)
+		}
+	})
+
+	t.Run("Reborn only happens once", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		reborn := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Reborn Minion"),
+			game.WithTag(game.TAG_REBORN, true))
+		g.AddEntityToField(player1, reborn, -1)
+
+		attacker := game.CreateTestMinionEntity(g, player2, game.WithAttack(5), game.WithHealth(10), game.WithTag(game.TAG_WINDFURY, true))
+		g.AddEntityToField(player2, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, reborn, false); err != nil {
+			t.Fatalf("Expected first attack to succeed, but got error: %v", err)
+		}
+		if len(player1.Field) != 1 {
+			t.Fatalf("Expected reborn minion to return, got %d minions", len(player1.Field))
+		}
+
+		if err := g.Attack(attacker, player1.Field[0], false); err != nil {
+			t.Fatalf("Expected second attack to succeed, but got error: %v", err)
+		}
+		if len(player1.Field) != 0 {
+			t.Errorf("Expected reborn minion to stay dead, got %d minions", len(player1.Field))
+		}
+	})
+
+	t.Run("Reborn fails when the board is full", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		reborn := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Reborn Minion"),
+			game.WithTag(game.TAG_REBORN, true),
+			game.WithPower(game.PowerTypeDeathrattle, func(g *game.Game, source, target *game.Entity) {
+				// Fill the board before reborn resolves
+				for len(source.Owner.Field) < source.Owner.FieldSize {
+					g.AddEntityToField(source.Owner, game.CreateTestMinionEntity(g, source.Owner, game.WithName("Token")), -1)
+				}
+			}))
+		g.AddEntityToField(player1, reborn, -1)
+
+		attacker := game.CreateTestMinionEntity(g, player2, game.WithAttack(5), game.WithHealth(5))
+		g.AddEntityToField(player2, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, reborn, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		for _, minion := range player1.Field {
+			if minion.Card.Name == "Reborn Minion" {
+				t.Errorf("Expected reborn minion not to return to a full board")
+			}
+		}
+	})
+
+	t.Run("Silenced reborn minion keeps 1 health", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		reborn := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Reborn Minion"),
+			game.WithAttack(1),
+			game.WithHealth(3),
+			game.WithTag(game.TAG_REBORN, true))
+		g.AddEntityToField(player1, reborn, -1)
+
+		attacker := game.CreateTestMinionEntity(g, player2, game.WithAttack(5), game.WithHealth(5))
+		g.AddEntityToField(player2, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, reborn, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+		if len(player1.Field) != 1 {
+			t.Fatalf("Expected the reborn minion to return, got %d minions", len(player1.Field))
+		}
+
+		returned := player1.Field[0]
+		g.Silence(returned)
+		if returned.Attack != 1 || returned.Health != 1 || returned.MaxHealth != 1 {
+			t.Errorf("Expected silenced reborn minion to stay 1/1, got %d/%d (max %d)",
+				returned.Attack, returned.Health, returned.MaxHealth)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+// createTestSecret creates a secret that counts how often it fires when the opponent plays a card
+func createTestSecret(g *game.Game, player *game.Player, name string, fired *int) *game.Entity {
+	return game.CreateTestSpellEntity(g, player,
+		game.WithName(name),
+		game.WithCost(0),
+		game.WithTag(game.TAG_SECRET, true),
+		func(c *game.Card) {
+			c.Load = func(g *game.Game, self *game.Entity) {
+				g.TriggerManager.RegisterTrigger(game.TriggerCardPlayed, self, func(ctx *game.TriggerContext, self *game.Entity) {
+					if !ctx.Game.SecretActive(self) {
+						return
+					}
+					ctx.Game.RevealSecret(self)
+					*fired++
+				}, false)
+			}
+		})
+}
+
+func TestSecret(t *testing.T) {
+	t.Run("Playing a secret puts it into the secret zone", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		fired := 0
+		secret := createTestSecret(g, player, "Test Secret", &fired)
+		player.Hand = []*game.Entity{secret}
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play secret: %v", err)
+		}
+
+		if len(player.Secrets) != 1 || secret.CurrentZone != game.ZONE_SECRET {
+			t.Errorf("Expected secret in the secret zone, got %d secrets and zone %s", len(player.Secrets), secret.CurrentZone)
+		}
+		if fired != 0 {
+			t.Errorf("Expected secret not to fire on its owner's turn")
+		}
+	})
+
+	t.Run("Secret fires on the opponent's turn and is revealed", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		fired := 0
+		secret := createTestSecret(g, player, "Test Secret", &fired)
+		g.AddSecret(player, secret)
+
+		// Playing another card on our own turn does not fire it
+		player.Hand = []*game.Entity{game.CreateTestMinionEntity(g, player, game.WithCost(0))}
+		if err := e.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+		if fired != 0 {
+			t.Fatalf("Expected secret not to fire on its owner's turn")
+		}
+
+		e.EndPlayerTurn()
+		opponent.Hand = []*game.Entity{game.CreateTestMinionEntity(g, opponent, game.WithCost(0))}
+		opponent.Mana = 10
+		if err := e.PlayCard(opponent, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if fired != 1 {
+			t.Errorf("Expected secret to fire once, fired %d times", fired)
+		}
+		if len(player.Secrets) != 0 || secret.CurrentZone != game.ZONE_GRAVEYARD {
+			t.Errorf("Expected revealed secret in the graveyard, got %d secrets and zone %s", len(player.Secrets), secret.CurrentZone)
+		}
+	})
+
+	t.Run("Duplicate secrets cannot be played", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		fired := 0
+		g.AddSecret(player, createTestSecret(g, player, "Test Secret", &fired))
+		player.Hand = []*game.Entity{createTestSecret(g, player, "Test Secret", &fired)}
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err == nil {
+			t.Errorf("Expected duplicate secret to be rejected")
+		}
+		if len(player.Secrets) != 1 || len(player.Hand) != 1 {
+			t.Errorf("Expected duplicate secret to stay in hand")
+		}
+	})
+
+	t.Run("Secret zone holds at most five secrets", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+		player := g.CurrentPlayer
+
+		fired := 0
+		for i := 0; i < game.MaxSecrets; i++ {
+			if !g.AddSecret(player, createTestSecret(g, player, fmt.Sprintf("Test Secret %d", i), &fired)) {
+				t.Fatalf("Expected secret %d to be added", i)
+			}
+		}
+		player.Hand = []*game.Entity{createTestSecret(g, player, "One Too Many", &fired)}
+
+		if err := e.PlayCard(player, 0, nil, -1, 0); err == nil {
+			t.Errorf("Expected sixth secret to be rejected")
+		}
+		if len(player.Secrets) != game.MaxSecrets {
+			t.Errorf("Expected %d secrets, got %d", game.MaxSecrets, len(player.Secrets))
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestSilence(t *testing.T) {
+	t.Run("Silence removes card text tags but keeps status tags", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithTag(game.TAG_TAUNT, true),
+			game.WithTag(game.TAG_DIVINE_SHIELD, true),
+			game.WithTag(game.TAG_SPELLPOWER, 1),
+			game.WithTag(game.TAG_DORMANT, 2))
+		g.AddEntityToField(player1, minion, 0)
+		g.Freeze(minion)
+
+		g.Silence(minion)
+
+		for _, tagType := range []game.TagType{game.TAG_TAUNT, game.TAG_DIVINE_SHIELD, game.TAG_SPELLPOWER, game.TAG_FROZEN} {
+			if game.HasTag(minion.Tags, tagType) {
+				t.Errorf("Expected tag %d to be removed by silence", tagType)
+			}
+		}
+		if !game.IsDormant(minion) {
+			t.Errorf("Expected dormant status to survive silence")
+		}
+		if !game.IsSilenced(minion) {
+			t.Errorf("Expected minion to be marked as silenced")
+		}
+		if g.SpellDamage(player1) != 0 {
+			t.Errorf("Expected no Spell Damage after silence, got %d", g.SpellDamage(player1))
+		}
+	})
+
+	t.Run("Silence recomputes stats from the base card", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		minion := game.CreateTestMinionEntity(g, player1, game.WithAttack(2), game.WithHealth(3))
+		g.AddEntityToField(player1, minion, 0)
+
+		// Buffed to 4/6 and damaged to 4/5
+		minion.Attack = 4
+		minion.MaxHealth = 6
+		minion.Health = 5
+
+		g.Silence(minion)
+
+		if minion.Attack != 2 {
+			t.Errorf("Expected attack to return to 2, got %d", minion.Attack)
+		}
+		if minion.MaxHealth != 3 || minion.Health != 3 {
+			t.Errorf("Expected health to return to 3/3, got %d/%d", minion.Health, minion.MaxHealth)
+		}
+
+		// Damage is kept when it leaves the minion below its base health
+		minion.Health = 1
+		g.Silence(minion)
+		if minion.Health != 1 {
+			t.Errorf("Expected damaged minion to keep 1 health, got %d", minion.Health)
+		}
+	})
+
+	t.Run("Silence unloads triggers and deathrattles", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+
+		unloaded := false
+		deathrattle := false
+		minion := game.CreateTestMinionEntity(g, player1,
+			game.WithPower(game.PowerTypeDeathrattle, func(g *game.Game, source, target *game.Entity) {
+				deathrattle = true
+			}),
+			func(c *game.Card) {
+				c.Unload = func(g *game.Game, e *game.Entity) {
+					unloaded = true
+				}
+			})
+		g.AddEntityToField(player1, minion, 0)
+
+		fired := 0
+		g.TriggerManager.RegisterTrigger(game.TriggerMinionSummoned, minion, func(ctx *game.TriggerContext, self *game.Entity) {
+			fired++
+		}, false)
+
+		g.Silence(minion)
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1), -1)
+
+		if !unloaded {
+			t.Errorf("Expected card Unload to be called")
+		}
+		if fired != 0 {
+			t.Errorf("Expected triggers of a silenced minion not to fire, fired %d times", fired)
+		}
+
+		minion.IsDestroyed = true
+		g.ProcessGraveyard()
+		if deathrattle {
+			t.Errorf("Expected deathrattle of a silenced minion not to run")
+		}
+	})
+}
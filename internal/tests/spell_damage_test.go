@@ -0,0 +1,89 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestSpellDamage(t *testing.T) {
+	t.Run("Spell Damage sums friendly spellpower values", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER, 1)), -1)
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER, 2)), -1)
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1), -1)
+		// Enemy Spell Damage does not count
+		g.AddEntityToField(player2, game.CreateTestMinionEntity(g, player2, game.WithTag(game.TAG_SPELLPOWER, 5)), -1)
+
+		if got := g.SpellDamage(player1); got != 3 {
+			t.Errorf("Expected Spell Damage of 3, got %d", got)
+		}
+
+		spell := game.CreateTestSpellEntity(g, player1)
+		initialHealth := player2.Hero.Health
+		dealt := g.DealSpellDamage(spell, player2.Hero, 2)
+
+		if dealt != 5 {
+			t.Errorf("Expected 5 damage dealt, got %d", dealt)
+		}
+		if player2.Hero.Health != initialHealth-5 {
+			t.Errorf("Expected enemy hero health to be %d, got %d", initialHealth-5, player2.Hero.Health)
+		}
+	})
+
+	t.Run("Spell damage doubling applies after Spell Damage", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER, 1)), -1)
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER_DOUBLE, true)), -1)
+
+		spell := game.CreateTestSpellEntity(g, player1)
+		initialHealth := player2.Hero.Health
+		g.DealSpellDamage(spell, player2.Hero, 3)
+
+		// (3 + 1) * 2
+		if player2.Hero.Health != initialHealth-8 {
+			t.Errorf("Expected enemy hero health to be %d, got %d", initialHealth-8, player2.Hero.Health)
+		}
+
+		// A second doubler doubles again
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER_DOUBLE, true)), -1)
+		if got := g.CalculateSpellDamage(player1, 3); got != 16 {
+			t.Errorf("Expected 16 damage with two doublers, got %d", got)
+		}
+	})
+
+	t.Run("Plain damage ignores Spell Damage", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER, 2)), -1)
+
+		spell := game.CreateTestSpellEntity(g, player1)
+		initialHealth := player2.Hero.Health
+		g.DealDamage(spell, player2.Hero, 3)
+
+		if player2.Hero.Health != initialHealth-3 {
+			t.Errorf("Expected enemy hero health to be %d, got %d", initialHealth-3, player2.Hero.Health)
+		}
+	})
+}
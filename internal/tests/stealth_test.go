@@ -0,0 +1,115 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestStealth(t *testing.T) {
+	t.Run("Stealthed minion cannot be attacked", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Test Attacker"),
+			game.WithAttack(2),
+			game.WithHealth(2))
+		stealthed := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Stealthed Minion"),
+			game.WithAttack(2),
+			game.WithHealth(2),
+			game.WithTag(game.TAG_STEALTH, true))
+
+		g.AddEntityToField(player1, attacker, 0)
+		g.AddEntityToField(player2, stealthed, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, stealthed, false); err == nil {
+			t.Errorf("Expected attack on stealthed minion to fail")
+		}
+	})
+
+	t.Run("Stealthed minion cannot be targeted by the opponent", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+		player1.Mana = 10
+		player2.Mana = 10
+
+		stealthed := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Stealthed Minion"),
+			game.WithTag(game.TAG_STEALTH, true))
+		g.AddEntityToField(player2, stealthed, 0)
+
+		spell := game.CreateTestSpellEntity(g, player1,
+			game.WithRequirement(game.REQ_TARGET_TO_PLAY, 0))
+		if err := g.TestPlayCard(player1, spell, stealthed, 0); err == nil {
+			t.Errorf("Expected targeting an enemy stealthed minion to fail")
+		}
+
+		// The owner can still target it
+		friendlySpell := game.CreateTestSpellEntity(g, player2,
+			game.WithRequirement(game.REQ_TARGET_TO_PLAY, 0))
+		if err := g.TestPlayCard(player2, friendlySpell, stealthed, 0); err != nil {
+			t.Errorf("Expected targeting a friendly stealthed minion to succeed, but got error: %v", err)
+		}
+	})
+
+	t.Run("Minion loses stealth when it attacks", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Stealthed Attacker"),
+			game.WithAttack(2),
+			game.WithHealth(2),
+			game.WithTag(game.TAG_STEALTH, true))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, player2.Hero, false); err != nil {
+			t.Fatalf("Expected attack to succeed, but got error: %v", err)
+		}
+
+		if game.HasTag(attacker.Tags, game.TAG_STEALTH) {
+			t.Errorf("Expected attacker to lose stealth after attacking")
+		}
+	})
+
+	t.Run("Minion loses stealth when it deals damage", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		source := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Stealthed Minion"),
+			game.WithTag(game.TAG_STEALTH, true))
+		g.AddEntityToField(player1, source, 0)
+
+		g.DealDamage(source, player2.Hero, 1)
+
+		if game.HasTag(source.Tags, game.TAG_STEALTH) {
+			t.Errorf("Expected minion to lose stealth after dealing damage")
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+func TestTaunt(t *testing.T) {
+	t.Run("Must attack taunt minion first", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		// Create attacker and defenders
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Test Attacker"),
+			game.WithAttack(2),
+			game.WithHealth(5))
+		taunt := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Taunt Minion"),
+			game.WithAttack(1),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_TAUNT, true))
+		other := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Other Minion"),
+			game.WithAttack(1),
+			game.WithHealth(5))
+
+		g.AddEntityToField(player1, attacker, 0)
+		g.AddEntityToField(player2, taunt, 0)
+		g.AddEntityToField(player2, other, 1)
+		attacker.Exhausted = false
+
+		// Cannot attack the other minion or the hero
+		if err := g.Attack(attacker, other, false); err == nil {
+			t.Errorf("Expected attack on non-taunt minion to fail")
+		}
+		if err := g.Attack(attacker, player2.Hero, false); err == nil {
+			t.Errorf("Expected attack on hero to fail")
+		}
+
+		// Can attack the taunt minion
+		if err := g.Attack(attacker, taunt, false); err != nil {
+			t.Errorf("Expected attack on taunt minion to succeed, but got error: %v", err)
+		}
+	})
+
+	t.Run("Attacker's own taunt does not restrict", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Test Attacker"),
+			game.WithAttack(2),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_TAUNT, true))
+		g.AddEntityToField(player1, attacker, 0)
+		attacker.Exhausted = false
+
+		if err := g.Attack(attacker, player2.Hero, false); err != nil {
+			t.Errorf("Expected attack on hero to succeed, but got error: %v", err)
+		}
+	})
+
+	t.Run("Stealthed taunt does not count", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1,
+			game.WithName("Test Attacker"),
+			game.WithAttack(2),
+			game.WithHealth(5))
+		stealthedTaunt := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Stealthed Taunt"),
+			game.WithAttack(1),
+			game.WithHealth(5),
+			game.WithTag(game.TAG_TAUNT, true),
+			game.WithTag(game.TAG_STEALTH, true))
+
+		g.AddEntityToField(player1, attacker, 0)
+		g.AddEntityToField(player2, stealthedTaunt, 0)
+		attacker.Exhausted = false
+
+		// The stealthed taunt itself cannot be attacked
+		if err := g.Attack(attacker, stealthedTaunt, false); err == nil {
+			t.Errorf("Expected attack on stealthed taunt to fail")
+		}
+
+		// The hero can be attacked past the stealthed taunt
+		if err := g.Attack(attacker, player2.Hero, false); err != nil {
+			t.Errorf("Expected attack on hero to succeed, but got error: %v", err)
+		}
+	})
+
+	t.Run("Legal actions respect taunt", func(t *testing.T) {
+		// Setup game
+		g := game.CreateTestGame()
+		e := engine.NewEngine(g)
+		e.StartGame()
+
+		player1 := g.Players[0]
+		player2 := g.Players[1]
+
+		attacker := game.CreateTestMinionEntity(g, player1, game.WithName("Test Attacker"))
+		taunt := game.CreateTestMinionEntity(g, player2,
+			game.WithName("Taunt Minion"),
+			game.WithTag(game.TAG_TAUNT, true))
+		g.AddEntityToField(player1, attacker, 0)
+		g.AddEntityToField(player2, taunt, 0)
+		g.AddEntityToField(player2, game.CreateTestMinionEntity(g, player2), 1)
+		attacker.Exhausted = false
+
+		for _, action := range e.LegalActions(player1) {
+			if action.Type == game.ActionAttack && action.Target != taunt {
+				t.Errorf("Expected only the taunt minion to be a legal attack target, got %s", action.Target.Card.Name)
+			}
+		}
+	})
+}
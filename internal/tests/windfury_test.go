@@ -152,9 +152,6 @@ func TestWindfury(t *testing.T) {
 
 		player.Weapon = weapon
 
-		// Set hero's attack value to match weapon's attack
-		player.Hero.Attack = weapon.Attack
-
 		// Create two defender entities for the opponent
 		defender1 := game.CreateTestMinionEntity(g, opponent,
 			game.WithName("Defender 1"),
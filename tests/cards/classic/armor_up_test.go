@@ -0,0 +1,36 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var armorUpCard *game.Card
+
+func init() {
+	(&cards.ArmorUp{}).Register(game.GetCardManager())
+	armorUpCard, _ = game.GetCardManager().CreateCardInstance("Armor Up!")
+}
+
+// TestArmorUpEffect tests that Armor Up! gives 2 armor
+func TestArmorUpEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(armorUpCard, g, player1)
+
+	if err := g.UseHeroPower(player1, nil); err != nil {
+		t.Fatalf("Failed to use Armor Up!: %v", err)
+	}
+
+	if player1.Hero.Armor != 2 {
+		t.Errorf("Expected hero armor to be 2 after Armor Up!, got %d", player1.Hero.Armor)
+	}
+}
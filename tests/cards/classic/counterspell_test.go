@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var counterspellCard *game.Card
+
+func init() {
+	(&cards.Counterspell{}).Register(game.GetCardManager())
+	counterspellCard, _ = game.GetCardManager().CreateCardInstance("Counterspell")
+}
+
+// TestCounterspellEffect tests that Counterspell counters the opponent's next spell
+func TestCounterspellEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+	player := g.CurrentPlayer
+	opponent := g.Players[1-g.CurrentPlayerIndex]
+
+	counterspell := game.NewEntity(counterspellCard, g, player)
+	player.Hand = []*game.Entity{counterspell}
+	player.Mana = 10
+	if err := engine.PlayCard(player, 0, nil, -1, 0); err != nil {
+		t.Fatalf("Failed to play Counterspell: %v", err)
+	}
+	engine.EndPlayerTurn()
+
+	// The opponent casts a spell
+	resolved := false
+	spell := game.CreateTestSpellEntity(g, opponent,
+		game.WithName("Test Spell"),
+		game.WithCost(1),
+		game.WithPower(game.PowerTypeSpell, func(g *game.Game, source, target *game.Entity) {
+			resolved = true
+		}))
+	opponent.Hand = []*game.Entity{spell}
+	opponent.Mana = 10
+	if err := engine.PlayCard(opponent, 0, nil, -1, 0); err != nil {
+		t.Fatalf("Failed to play spell: %v", err)
+	}
+
+	if resolved {
+		t.Errorf("Expected spell to be countered")
+	}
+	if opponent.Mana != 9 {
+		t.Errorf("Expected countered spell to still cost mana, got %d mana", opponent.Mana)
+	}
+	if spell.CurrentZone != game.ZONE_GRAVEYARD {
+		t.Errorf("Expected countered spell in the graveyard, got %s", spell.CurrentZone)
+	}
+	if len(player.Secrets) != 0 || counterspell.CurrentZone != game.ZONE_GRAVEYARD {
+		t.Errorf("Expected Counterspell to be revealed")
+	}
+}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var daggerMasteryCard *game.Card
+
+func init() {
+	(&cards.DaggerMastery{}).Register(game.GetCardManager())
+	(&cards.WickedKnife{}).Register(game.GetCardManager())
+	daggerMasteryCard, _ = game.GetCardManager().CreateCardInstance("Dagger Mastery")
+}
+
+// TestDaggerMasteryEffect tests that Dagger Mastery equips a 1/2 weapon
+func TestDaggerMasteryEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(daggerMasteryCard, g, player1)
+
+	if err := g.UseHeroPower(player1, nil); err != nil {
+		t.Fatalf("Failed to use Dagger Mastery: %v", err)
+	}
+
+	weapon := player1.Weapon
+	if weapon == nil {
+		t.Fatalf("Expected a weapon to be equipped")
+	}
+	if weapon.Card.Name != "Wicked Knife" || weapon.Attack != 1 || weapon.Health != 2 {
+		t.Errorf("Expected a 1/2 Wicked Knife, got %s (%d/%d)", weapon.Card.Name, weapon.Attack, weapon.Health)
+	}
+}
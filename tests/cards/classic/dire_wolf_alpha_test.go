@@ -0,0 +1,60 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var direWolfAlphaCard *game.Card
+
+func init() {
+	(&cards.DireWolfAlpha{}).Register(game.GetCardManager())
+	direWolfAlphaCard, _ = game.GetCardManager().CreateCardInstance("Dire Wolf Alpha")
+}
+
+// TestDireWolfAlphaEffect tests that Dire Wolf Alpha gives adjacent minions +1 attack
+func TestDireWolfAlphaEffect(t *testing.T) {
+	t.Run("Adjacent minions gain attack while Dire Wolf Alpha is in play", func(t *testing.T) {
+		// Setup
+		g := game.CreateTestGame()
+		engine := engine.NewEngine(g)
+		engine.StartGame()
+		player := g.CurrentPlayer
+
+		left := game.CreateTestMinionEntity(g, player, game.WithName("Left"), game.WithAttack(1), game.WithHealth(1))
+		right := game.CreateTestMinionEntity(g, player, game.WithName("Right"), game.WithAttack(1), game.WithHealth(1))
+		far := game.CreateTestMinionEntity(g, player, game.WithName("Far"), game.WithAttack(1), game.WithHealth(1))
+		engine.AddEntityToField(player, left, -1)
+		engine.AddEntityToField(player, right, -1)
+		engine.AddEntityToField(player, far, -1)
+
+		// Play Dire Wolf Alpha between the first two minions
+		wolf := game.NewEntity(direWolfAlphaCard, g, player)
+		player.Hand = []*game.Entity{wolf}
+		player.Mana = 10
+		if err := engine.PlayCard(player, 0, nil, 1, 0); err != nil {
+			t.Fatalf("Failed to play Dire Wolf Alpha: %v", err)
+		}
+
+		if left.Attack != 2 || right.Attack != 2 {
+			t.Errorf("Expected adjacent minions to have 2 attack, got %d and %d", left.Attack, right.Attack)
+		}
+		if far.Attack != 1 {
+			t.Errorf("Expected other minion to keep 1 attack, got %d", far.Attack)
+		}
+		if wolf.Attack != 2 {
+			t.Errorf("Expected Dire Wolf Alpha not to buff itself, got %d attack", wolf.Attack)
+		}
+
+		// The aura goes away once the wolf dies
+		g.DealDamage(nil, wolf, 2)
+		g.ProcessDestroyAndUpdateAura()
+
+		if left.Attack != 1 || right.Attack != 1 {
+			t.Errorf("Expected aura to be removed, got %d and %d attack", left.Attack, right.Attack)
+		}
+	})
+}
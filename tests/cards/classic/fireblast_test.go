@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var fireblastCard *game.Card
+
+func init() {
+	(&cards.Fireblast{}).Register(game.GetCardManager())
+	fireblastCard, _ = game.GetCardManager().CreateCardInstance("Fireblast")
+}
+
+// TestFireblastProperties tests that Fireblast has the correct properties
+func TestFireblastProperties(t *testing.T) {
+	if fireblastCard.Cost != 2 {
+		t.Errorf("Expected Fireblast cost to be 2, got %d", fireblastCard.Cost)
+	}
+	if fireblastCard.Type != game.HeroPower {
+		t.Errorf("Expected Fireblast type to be Hero Power, got %s", fireblastCard.Type)
+	}
+}
+
+// TestFireblastEffect tests that Fireblast deals 1 damage to the target
+func TestFireblastEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(fireblastCard, g, player1)
+
+	// Fireblast needs a target
+	if err := g.UseHeroPower(player1, nil); err == nil {
+		t.Errorf("Expected Fireblast to require a target")
+	}
+
+	minion := game.CreateTestMinionEntity(g, player2, game.WithHealth(3))
+	g.AddEntityToField(player2, minion, -1)
+
+	if err := g.UseHeroPower(player1, minion); err != nil {
+		t.Fatalf("Failed to use Fireblast: %v", err)
+	}
+
+	if minion.Health != 2 {
+		t.Errorf("Expected minion health to be 2 after Fireblast, got %d", minion.Health)
+	}
+	if player1.Mana != 8 {
+		t.Errorf("Expected player mana to be 8 after Fireblast, got %d", player1.Mana)
+	}
+}
+
+// TestFireblastSpellDamage tests that Fireblast is boosted by Spell Damage
+func TestFireblastSpellDamage(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(fireblastCard, g, player1)
+
+	g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER, 2)), -1)
+	initialHealth := player2.Hero.Health
+
+	if err := g.UseHeroPower(player1, player2.Hero); err != nil {
+		t.Fatalf("Failed to use Fireblast: %v", err)
+	}
+
+	// 1 damage plus Spell Damage +2
+	if player2.Hero.Health != initialHealth-3 {
+		t.Errorf("Expected enemy hero health to be %d, got %d", initialHealth-3, player2.Hero.Health)
+	}
+}
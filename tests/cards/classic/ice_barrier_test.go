@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var iceBarrierCard *game.Card
+
+func init() {
+	(&cards.IceBarrier{}).Register(game.GetCardManager())
+	iceBarrierCard, _ = game.GetCardManager().CreateCardInstance("Ice Barrier")
+}
+
+// TestIceBarrierEffect tests that Ice Barrier gives armor before the hero is hit
+func TestIceBarrierEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+	player := g.CurrentPlayer
+	opponent := g.Players[1-g.CurrentPlayerIndex]
+
+	g.AddSecret(player, game.NewEntity(iceBarrierCard, g, player))
+	engine.EndPlayerTurn()
+
+	attacker := game.CreateTestMinionEntity(g, opponent,
+		game.WithName("Attacker"),
+		game.WithAttack(3),
+		game.WithHealth(3))
+	engine.AddEntityToField(opponent, attacker, -1)
+	attacker.Exhausted = false
+
+	initialHealth := player.Hero.Health
+	if err := engine.Attack(attacker, player.Hero, false); err != nil {
+		t.Fatalf("Failed to attack: %v", err)
+	}
+
+	if player.Hero.Armor != 5 || player.Hero.Health != initialHealth {
+		t.Errorf("Expected 5 armor and %d health, got %d armor and %d health",
+			initialHealth, player.Hero.Armor, player.Hero.Health)
+	}
+	if len(player.Secrets) != 0 {
+		t.Errorf("Expected Ice Barrier to be revealed")
+	}
+}
@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var lesserHealCard *game.Card
+
+func init() {
+	(&cards.LesserHeal{}).Register(game.GetCardManager())
+	lesserHealCard, _ = game.GetCardManager().CreateCardInstance("Lesser Heal")
+}
+
+// TestLesserHealEffect tests that Lesser Heal restores 2 health
+func TestLesserHealEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(lesserHealCard, g, player1)
+
+	minion := game.CreateTestMinionEntity(g, player1, game.WithHealth(5))
+	g.AddEntityToField(player1, minion, -1)
+	minion.Health = 1
+
+	if err := g.UseHeroPower(player1, minion); err != nil {
+		t.Fatalf("Failed to use Lesser Heal: %v", err)
+	}
+
+	if minion.Health != 3 {
+		t.Errorf("Expected minion health to be 3 after Lesser Heal, got %d", minion.Health)
+	}
+}
@@ -0,0 +1,42 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var lifeTapCard *game.Card
+
+func init() {
+	(&cards.LifeTap{}).Register(game.GetCardManager())
+	lifeTapCard, _ = game.GetCardManager().CreateCardInstance("Life Tap")
+}
+
+// TestLifeTapEffect tests that Life Tap draws a card and deals 2 damage to the hero
+func TestLifeTapEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(lifeTapCard, g, player1)
+
+	handSize := len(player1.Hand)
+	initialHealth := player1.Hero.Health
+
+	if err := g.UseHeroPower(player1, nil); err != nil {
+		t.Fatalf("Failed to use Life Tap: %v", err)
+	}
+
+	if len(player1.Hand) != handSize+1 {
+		t.Errorf("Expected hand size to be %d after Life Tap, got %d", handSize+1, len(player1.Hand))
+	}
+	if player1.Hero.Health != initialHealth-2 {
+		t.Errorf("Expected hero health to be %d after Life Tap, got %d", initialHealth-2, player1.Hero.Health)
+	}
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var mirrorEntityCard *game.Card
+
+func init() {
+	(&cards.MirrorEntity{}).Register(game.GetCardManager())
+	mirrorEntityCard, _ = game.GetCardManager().CreateCardInstance("Mirror Entity")
+}
+
+// TestMirrorEntityEffect tests that Mirror Entity copies the next minion the opponent plays
+func TestMirrorEntityEffect(t *testing.T) {
+	t.Run("Mirror Entity summons a copy of the played minion", func(t *testing.T) {
+		// Setup
+		g := game.CreateTestGame()
+		engine := engine.NewEngine(g)
+		engine.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		mirror := game.NewEntity(mirrorEntityCard, g, player)
+		player.Hand = []*game.Entity{mirror}
+		player.Mana = 10
+		if err := engine.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play Mirror Entity: %v", err)
+		}
+		engine.EndPlayerTurn()
+
+		minion := game.CreateTestMinionEntity(g, opponent,
+			game.WithName("Test Minion"),
+			game.WithCost(1),
+			game.WithAttack(2),
+			game.WithHealth(3))
+		opponent.Hand = []*game.Entity{minion}
+		opponent.Mana = 10
+		if err := engine.PlayCard(opponent, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if len(player.Field) != 1 {
+			t.Fatalf("Expected a copy on the player's field, got %d minions", len(player.Field))
+		}
+		copied := player.Field[0]
+		if copied == minion || copied.Card.Name != "Test Minion" || copied.Owner != player {
+			t.Errorf("Expected a copy of the minion owned by the player")
+		}
+		if copied.Attack != 2 || copied.Health != 3 {
+			t.Errorf("Expected copy to be 2/3, got %d/%d", copied.Attack, copied.Health)
+		}
+		if len(player.Secrets) != 0 {
+			t.Errorf("Expected Mirror Entity to be revealed")
+		}
+	})
+
+	t.Run("Mirror Entity stays hidden when the field is full", func(t *testing.T) {
+		// Setup
+		g := game.CreateTestGame()
+		engine := engine.NewEngine(g)
+		engine.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		g.AddSecret(player, game.NewEntity(mirrorEntityCard, g, player))
+		for len(player.Field) < player.FieldSize {
+			engine.AddEntityToField(player, game.CreateTestMinionEntity(g, player), -1)
+		}
+		engine.EndPlayerTurn()
+
+		opponent.Hand = []*game.Entity{game.CreateTestMinionEntity(g, opponent, game.WithCost(1))}
+		opponent.Mana = 10
+		if err := engine.PlayCard(opponent, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play minion: %v", err)
+		}
+
+		if len(player.Secrets) != 1 {
+			t.Errorf("Expected Mirror Entity to stay in play")
+		}
+	})
+}
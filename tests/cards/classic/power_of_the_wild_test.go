@@ -0,0 +1,66 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var powerOfTheWildCard *game.Card
+
+func init() {
+	(&cards.PowerOfTheWild{}).Register(game.GetCardManager())
+	(&cards.Panther{}).Register(game.GetCardManager())
+	powerOfTheWildCard, _ = game.GetCardManager().CreateCardInstance("Power of the Wild")
+}
+
+// TestPowerOfTheWildEffect tests both options of Power of the Wild
+func TestPowerOfTheWildEffect(t *testing.T) {
+	t.Run("Leader of the Pack gives your minions +1/+1", func(t *testing.T) {
+		// Setup
+		g := game.CreateTestGame()
+		engine := engine.NewEngine(g)
+		engine.StartGame()
+		player := g.CurrentPlayer
+
+		minion := game.CreateTestMinionEntity(g, player, game.WithAttack(1), game.WithHealth(1))
+		engine.AddEntityToField(player, minion, -1)
+		player.Hand = []*game.Entity{game.NewEntity(powerOfTheWildCard, g, player)}
+		player.Mana = 10
+
+		if err := engine.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play Power of the Wild: %v", err)
+		}
+
+		if minion.Attack != 2 || minion.Health != 2 {
+			t.Errorf("Expected minion to be 2/2, got %d/%d", minion.Attack, minion.Health)
+		}
+		if len(player.Field) != 1 {
+			t.Errorf("Expected no Panther, got %d minions", len(player.Field))
+		}
+	})
+
+	t.Run("Summon a Panther summons a 3/2", func(t *testing.T) {
+		// Setup
+		g := game.CreateTestGame()
+		engine := engine.NewEngine(g)
+		engine.StartGame()
+		player := g.CurrentPlayer
+
+		player.Hand = []*game.Entity{game.NewEntity(powerOfTheWildCard, g, player)}
+		player.Mana = 10
+
+		if err := engine.PlayCard(player, 0, nil, -1, 1); err != nil {
+			t.Fatalf("Failed to play Power of the Wild: %v", err)
+		}
+
+		if len(player.Field) != 1 || player.Field[0].Card.Name != "Panther" {
+			t.Fatalf("Expected a Panther on the field")
+		}
+		if player.Field[0].Attack != 3 || player.Field[0].Health != 2 {
+			t.Errorf("Expected Panther to be 3/2, got %d/%d", player.Field[0].Attack, player.Field[0].Health)
+		}
+	})
+}
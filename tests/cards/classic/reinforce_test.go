@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var reinforceCard *game.Card
+
+func init() {
+	(&cards.Reinforce{}).Register(game.GetCardManager())
+	(&cards.SilverHandRecruit{}).Register(game.GetCardManager())
+	reinforceCard, _ = game.GetCardManager().CreateCardInstance("Reinforce")
+}
+
+// TestReinforceEffect tests that Reinforce summons a 1/1 Silver Hand Recruit
+func TestReinforceEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(reinforceCard, g, player1)
+
+	if err := g.UseHeroPower(player1, nil); err != nil {
+		t.Fatalf("Failed to use Reinforce: %v", err)
+	}
+
+	if len(player1.Field) != 1 {
+		t.Fatalf("Expected 1 minion on the field, got %d", len(player1.Field))
+	}
+	recruit := player1.Field[0]
+	if recruit.Card.Name != "Silver Hand Recruit" || recruit.Attack != 1 || recruit.Health != 1 {
+		t.Errorf("Expected a 1/1 Silver Hand Recruit, got %s (%d/%d)", recruit.Card.Name, recruit.Attack, recruit.Health)
+	}
+}
+
+// TestReinforceFullBoard tests that Reinforce cannot be used with a full board
+func TestReinforceFullBoard(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(reinforceCard, g, player1)
+
+	for len(player1.Field) < player1.FieldSize {
+		g.AddEntityToField(player1, game.CreateTestMinionEntity(g, player1), -1)
+	}
+
+	if err := g.UseHeroPower(player1, nil); err == nil {
+		t.Errorf("Expected Reinforce to fail with a full board")
+	}
+	if player1.Mana != 10 {
+		t.Errorf("Expected no mana to be spent, got %d", player1.Mana)
+	}
+}
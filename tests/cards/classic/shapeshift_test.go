@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var shapeshiftCard *game.Card
+
+func init() {
+	(&cards.Shapeshift{}).Register(game.GetCardManager())
+	shapeshiftCard, _ = game.GetCardManager().CreateCardInstance("Shapeshift")
+}
+
+// TestShapeshiftEffect tests that Shapeshift gives +1 attack this turn and 1 armor
+func TestShapeshiftEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	e := engine.NewEngine(g)
+	e.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(shapeshiftCard, g, player1)
+
+	if err := g.UseHeroPower(player1, nil); err != nil {
+		t.Fatalf("Failed to use Shapeshift: %v", err)
+	}
+
+	if g.EffectiveAttack(player1.Hero) != 1 {
+		t.Errorf("Expected hero attack to be 1 after Shapeshift, got %d", g.EffectiveAttack(player1.Hero))
+	}
+	if player1.Hero.Armor != 1 {
+		t.Errorf("Expected hero armor to be 1 after Shapeshift, got %d", player1.Hero.Armor)
+	}
+
+	// The attack is gone at the end of the turn
+	if err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player1}); err != nil {
+		t.Fatalf("Failed to end turn: %v", err)
+	}
+	if g.EffectiveAttack(player1.Hero) != 0 {
+		t.Errorf("Expected hero attack to be 0 after the turn ended, got %d", g.EffectiveAttack(player1.Hero))
+	}
+	if player1.Hero.Armor != 1 {
+		t.Errorf("Expected hero to keep its armor, got %d", player1.Hero.Armor)
+	}
+}
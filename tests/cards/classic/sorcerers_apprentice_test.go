@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var sorcerersApprenticeCard *game.Card
+
+func init() {
+	(&cards.SorcerersApprentice{}).Register(game.GetCardManager())
+	sorcerersApprenticeCard, _ = game.GetCardManager().CreateCardInstance("Sorcerer's Apprentice")
+}
+
+// TestSorcerersApprenticeEffect tests that Sorcerer's Apprentice reduces the cost of friendly spells
+func TestSorcerersApprenticeEffect(t *testing.T) {
+	t.Run("Friendly spells cost 1 less while Sorcerer's Apprentice is in play", func(t *testing.T) {
+		// Setup
+		g := game.CreateTestGame()
+		engine := engine.NewEngine(g)
+		engine.StartGame()
+		player := g.CurrentPlayer
+		opponent := g.Players[1-g.CurrentPlayerIndex]
+
+		apprentice := game.NewEntity(sorcerersApprenticeCard, g, player)
+		spell := game.CreateTestSpellEntity(g, player, game.WithName("Test Spell"), game.WithCost(3))
+		minion := game.CreateTestMinionEntity(g, player, game.WithName("Test Minion"), game.WithCost(3))
+		enemySpell := game.CreateTestSpellEntity(g, opponent, game.WithName("Enemy Spell"), game.WithCost(3))
+		player.Hand = []*game.Entity{apprentice}
+		g.AddEntityToHand(player, spell, -1)
+		g.AddEntityToHand(player, minion, -1)
+		g.AddEntityToHand(opponent, enemySpell, -1)
+		player.Mana = 10
+
+		if err := engine.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play Sorcerer's Apprentice: %v", err)
+		}
+
+		if spell.Cost != 2 {
+			t.Errorf("Expected friendly spell to cost 2, got %d", spell.Cost)
+		}
+		if minion.Cost != 3 {
+			t.Errorf("Expected minion to cost 3, got %d", minion.Cost)
+		}
+		if enemySpell.Cost != 3 {
+			t.Errorf("Expected enemy spell to cost 3, got %d", enemySpell.Cost)
+		}
+
+		// The spell is paid at its reduced cost
+		mana := player.Mana
+		if err := engine.PlayCard(player, 0, nil, -1, 0); err != nil {
+			t.Fatalf("Failed to play spell: %v", err)
+		}
+		if player.Mana != mana-2 {
+			t.Errorf("Expected 2 mana spent, got %d", mana-player.Mana)
+		}
+	})
+}
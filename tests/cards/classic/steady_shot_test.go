@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var steadyShotCard *game.Card
+
+func init() {
+	(&cards.SteadyShot{}).Register(game.GetCardManager())
+	steadyShotCard, _ = game.GetCardManager().CreateCardInstance("Steady Shot")
+}
+
+// TestSteadyShotEffect tests that Steady Shot deals 2 damage to the enemy hero
+func TestSteadyShotEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Mana = 10
+	player1.HeroPower = game.NewEntity(steadyShotCard, g, player1)
+
+	initialHealth := player2.Hero.Health
+
+	if err := g.UseHeroPower(player1, nil); err != nil {
+		t.Fatalf("Failed to use Steady Shot: %v", err)
+	}
+
+	if player2.Hero.Health != initialHealth-2 {
+		t.Errorf("Expected enemy hero health to be %d after Steady Shot, got %d", initialHealth-2, player2.Hero.Health)
+	}
+}
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var theCoinCard *game.Card
+
+func init() {
+	(&cards.TheCoin{}).Register(game.GetCardManager())
+	theCoinCard, _ = game.GetCardManager().CreateCardInstance("The Coin")
+}
+
+// TestTheCoinProperties tests that The Coin has the correct properties
+func TestTheCoinProperties(t *testing.T) {
+	if theCoinCard.Cost != 0 {
+		t.Errorf("Expected The Coin cost to be 0, got %d", theCoinCard.Cost)
+	}
+	if theCoinCard.Type != game.Spell {
+		t.Errorf("Expected The Coin type to be Spell, got %s", theCoinCard.Type)
+	}
+}
+
+// TestTheCoinGivenToSecondPlayer tests that only the second player starts with The Coin
+func TestTheCoinGivenToSecondPlayer(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+
+	for _, entity := range player1.Hand {
+		if entity.Card.Name == "The Coin" {
+			t.Errorf("Expected first player not to have The Coin")
+		}
+	}
+
+	// Second player has 4 opening cards plus The Coin
+	if len(player2.Hand) != 5 {
+		t.Fatalf("Expected second player hand size to be 5, got %d", len(player2.Hand))
+	}
+	coin := player2.Hand[len(player2.Hand)-1]
+	if coin.Card.Name != "The Coin" {
+		t.Errorf("Expected last card of second player to be The Coin, got %s", coin.Card.Name)
+	}
+	if coin.CurrentZone != game.ZONE_HAND {
+		t.Errorf("Expected The Coin zone to be HAND, got %s", coin.CurrentZone)
+	}
+}
+
+// TestTheCoinEffect tests that The Coin gives one mana crystal for the current turn only
+func TestTheCoinEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+	engine.EndPlayerTurn()
+
+	player2 := g.Players[1]
+	if player2.Mana != 1 {
+		t.Fatalf("Expected second player mana to be 1, got %d", player2.Mana)
+	}
+
+	// Find The Coin, the turn's draw is after it
+	coinIndex := -1
+	for i, entity := range player2.Hand {
+		if entity.Card.Name == "The Coin" {
+			coinIndex = i
+		}
+	}
+	if coinIndex == -1 {
+		t.Fatalf("Expected second player to have The Coin")
+	}
+
+	// Play The Coin
+	err := g.PlayCard(player2, coinIndex, nil, -1, 0)
+	if err != nil {
+		t.Fatalf("Failed to play The Coin: %v", err)
+	}
+
+	if player2.Mana != 2 {
+		t.Errorf("Expected mana to be 2 after playing The Coin, got %d", player2.Mana)
+	}
+	if player2.TotalMana != 1 {
+		t.Errorf("Expected total mana to stay 1 after playing The Coin, got %d", player2.TotalMana)
+	}
+
+	// The extra mana is gone next turn
+	engine.EndPlayerTurn()
+	engine.EndPlayerTurn()
+	if player2.Mana != 2 || player2.TotalMana != 2 {
+		t.Errorf("Expected mana to be 2/2 on the next turn, got %d/%d", player2.Mana, player2.TotalMana)
+	}
+}
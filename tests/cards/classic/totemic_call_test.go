@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"testing"
+
+	cards "github.com/openhs/cards/classic"
+	"github.com/openhs/internal/engine"
+	"github.com/openhs/internal/game"
+)
+
+var totemicCallCard *game.Card
+
+func init() {
+	(&cards.TotemicCall{}).Register(game.GetCardManager())
+	(&cards.HealingTotem{}).Register(game.GetCardManager())
+	(&cards.SearingTotem{}).Register(game.GetCardManager())
+	(&cards.StoneclawTotem{}).Register(game.GetCardManager())
+	(&cards.WrathOfAirTotem{}).Register(game.GetCardManager())
+	totemicCallCard, _ = game.GetCardManager().CreateCardInstance("Totemic Call")
+}
+
+// TestTotemicCallSummonsDifferentTotems tests that Totemic Call summons each basic totem once
+func TestTotemicCallSummonsDifferentTotems(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.HeroPower = game.NewEntity(totemicCallCard, g, player1)
+
+	for i := 0; i < len(cards.BasicTotems); i++ {
+		player1.Mana = 10
+		player1.HeroPower.Exhausted = false
+		if err := g.UseHeroPower(player1, nil); err != nil {
+			t.Fatalf("Failed to use Totemic Call: %v", err)
+		}
+	}
+
+	if len(player1.Field) != len(cards.BasicTotems) {
+		t.Fatalf("Expected %d totems on the field, got %d", len(cards.BasicTotems), len(player1.Field))
+	}
+	seen := make(map[string]bool)
+	for _, minion := range player1.Field {
+		if seen[minion.Card.Name] {
+			t.Errorf("Expected each totem once, got %s twice", minion.Card.Name)
+		}
+		seen[minion.Card.Name] = true
+	}
+}
+
+// TestHealingTotemEffect tests that Healing Totem heals friendly minions at the end of your turn
+func TestHealingTotemEffect(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	e := engine.NewEngine(g)
+	e.StartGame()
+
+	player1 := g.Players[0]
+
+	totem := g.Summon(player1, "Healing Totem", -1)
+	if totem == nil {
+		t.Fatalf("Failed to summon Healing Totem")
+	}
+	minion := game.CreateTestMinionEntity(g, player1, game.WithHealth(5))
+	g.AddEntityToField(player1, minion, -1)
+	minion.Health = 2
+
+	if err := e.PerformPlayerAction(game.Action{Type: game.ActionEndTurn, Player: player1}); err != nil {
+		t.Fatalf("Failed to end turn: %v", err)
+	}
+
+	if minion.Health != 3 {
+		t.Errorf("Expected minion health to be 3 after Healing Totem, got %d", minion.Health)
+	}
+}
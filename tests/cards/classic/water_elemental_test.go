@@ -250,3 +250,28 @@ func TestNoFreezeWhenNotDamagedByWaterElemental(t *testing.T) {
 		t.Errorf("Target minion should not be frozen when damaged by a non-Water Elemental minion")
 	}
 }
+
+// TestWaterElementalSilenced tests that a silenced Water Elemental no longer freezes
+func TestWaterElementalSilenced(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+
+	waterElementalEntity := game.NewEntity(waterElementalCard, g, player1)
+	g.AddEntityToField(player1, waterElementalEntity, -1)
+	waterElementalEntity.Exhausted = false
+
+	g.Silence(waterElementalEntity)
+
+	if err := g.Attack(waterElementalEntity, player2.Hero, false); err != nil {
+		t.Fatalf("Failed to attack with Water Elemental: %v", err)
+	}
+
+	if game.HasTag(player2.Hero.Tags, game.TAG_FROZEN) {
+		t.Errorf("Expected silenced Water Elemental not to freeze the enemy hero")
+	}
+}
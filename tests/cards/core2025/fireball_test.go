@@ -155,3 +155,63 @@ func TestFireballTargetingMinion(t *testing.T) {
 		t.Errorf("Expected minion to be in GRAVEYARD, got %s", weakMinion.CurrentZone)
 	}
 }
+
+// TestFireballSpellDamage tests that Fireball is boosted by Spell Damage
+func TestFireballSpellDamage(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Mana = 10
+
+	// Give player1 Spell Damage +1
+	spellpowerMinion := game.CreateTestMinionEntity(g, player1, game.WithTag(game.TAG_SPELLPOWER, 1))
+	g.AddEntityToField(player1, spellpowerMinion, -1)
+
+	fireballEntity := game.NewEntity(fireballCard, g, player1)
+	g.AddEntityToHand(player1, fireballEntity, -1)
+
+	initialHealth := player2.Hero.Health
+
+	err := g.PlayCard(player1, len(player1.Hand)-1, player2.Hero, -1, 0)
+	if err != nil {
+		t.Fatalf("Failed to play Fireball: %v", err)
+	}
+
+	// Check if 7 damage was dealt to enemy hero
+	expectedHealth := initialHealth - 7
+	if player2.Hero.Health != expectedHealth {
+		t.Errorf("Expected enemy hero health to be %d after Fireball with Spell Damage +1, got %d",
+			expectedHealth, player2.Hero.Health)
+	}
+}
+
+// TestFireballRequiresTarget tests that Fireball cannot be cast without a target
+func TestFireballRequiresTarget(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player1.Mana = 10
+
+	fireballEntity := game.NewEntity(fireballCard, g, player1)
+	g.AddEntityToHand(player1, fireballEntity, -1)
+
+	err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0)
+	if err == nil {
+		t.Fatalf("Expected Fireball without a target to fail")
+	}
+
+	// The card and the mana are kept
+	if fireballEntity.CurrentZone != game.ZONE_HAND {
+		t.Errorf("Expected Fireball to stay in HAND, got %s", fireballEntity.CurrentZone)
+	}
+	if player1.Mana != 10 {
+		t.Errorf("Expected mana to stay 10, got %d", player1.Mana)
+	}
+}
@@ -132,3 +132,32 @@ func TestFrostboltHero(t *testing.T) {
 		t.Errorf("Expected hero to be frozen after Frostbolt")
 	}
 }
+
+// TestFrostboltTargetsCharactersOnly tests that Frostbolt needs a character in play as its target
+func TestFrostboltTargetsCharactersOnly(t *testing.T) {
+	// Setup
+	g := game.CreateTestGame()
+	engine := engine.NewEngine(g)
+	engine.StartGame()
+
+	player1 := g.Players[0]
+	player2 := g.Players[1]
+	player1.Mana = 10
+
+	frostboltEntity := game.NewEntity(frostboltCard, g, player1)
+	g.AddEntityToHand(player1, frostboltEntity, -1)
+
+	// A minion in the opponent's hand is not a valid target
+	handMinion := game.CreateTestMinionEntity(g, player2)
+	g.AddEntityToHand(player2, handMinion, -1)
+
+	if err := g.PlayCard(player1, len(player1.Hand)-1, nil, -1, 0); err == nil {
+		t.Errorf("Expected Frostbolt without a target to fail")
+	}
+	if err := g.PlayCard(player1, len(player1.Hand)-1, handMinion, -1, 0); err == nil {
+		t.Errorf("Expected Frostbolt targeting a card in hand to fail")
+	}
+	if game.HasTag(handMinion.Tags, game.TAG_FROZEN) {
+		t.Errorf("Expected the card in hand not to be frozen")
+	}
+}